@@ -0,0 +1,164 @@
+// apperror 패키지는 repository, service 가 반환하는 도메인 오류를 정의합니다.
+//
+// 오류는 분류(Kind)와 클라이언트가 분기에 사용할 수 있는 고정 코드(Code)를 가지며,
+// HTTP 응답으로의 변환은 middleware.ErrorHandler 가 담당합니다.
+// 원인(Err)은 로그에만 남고 운영 환경의 응답에는 포함되지 않습니다.
+package apperror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Kind는 오류의 분류입니다.
+type Kind string
+
+const (
+	// 요청한 대상이 없는 경우
+	KindNotFound Kind = "not_found"
+	// 이미 존재하거나 현재 상태와 충돌하는 경우
+	KindConflict Kind = "conflict"
+	// 인증은 되었지만 권한이 없는 경우
+	KindForbidden Kind = "forbidden"
+	// 인증 정보가 없거나 올바르지 않은 경우
+	KindUnauthorized Kind = "unauthorized"
+	// 요청 값이 올바르지 않은 경우
+	KindValidation Kind = "validation"
+	// 경로가 해당 HTTP 메서드를 지원하지 않는 경우
+	KindMethodNotAllowed Kind = "method_not_allowed"
+	// 요청 본문의 Content-Type 을 지원하지 않는 경우
+	KindUnsupportedMediaType Kind = "unsupported_media_type"
+	// 데이터베이스 등 의존하는 서비스에 연결할 수 없는 경우, 잠시 후 재시도하면 성공할 수 있습니다.
+	KindUnavailable Kind = "unavailable"
+	// 클라이언트가 연결을 끊는 등 요청이 취소되어 처리를 중단한 경우
+	KindCanceled Kind = "canceled"
+	// 그 외 서버 내부 오류
+	KindInternal Kind = "internal"
+)
+
+// errors.Is 로 분류를 비교하기 위한 error 값
+var (
+	ErrNotFound             = &Error{Kind: KindNotFound}
+	ErrConflict             = &Error{Kind: KindConflict}
+	ErrForbidden            = &Error{Kind: KindForbidden}
+	ErrUnauthorized         = &Error{Kind: KindUnauthorized}
+	ErrValidation           = &Error{Kind: KindValidation}
+	ErrMethodNotAllowed     = &Error{Kind: KindMethodNotAllowed}
+	ErrUnsupportedMediaType = &Error{Kind: KindUnsupportedMediaType}
+	ErrUnavailable          = &Error{Kind: KindUnavailable}
+	ErrCanceled             = &Error{Kind: KindCanceled}
+	ErrInternal             = &Error{Kind: KindInternal}
+)
+
+// 여러 곳에서 공통으로 사용하는 오류
+var (
+	ErrInvalidRequest = New(KindValidation, "invalid_request", "요청 형식이 잘못되었습니다")
+	ErrReadOnlyField  = New(KindValidation, "read_only_field", "수정할 수 없는 필드입니다")
+	ErrContentType    = New(KindUnsupportedMediaType, "unsupported_content_type", "지원하지 않는 Content-Type 입니다")
+	errUnavailable    = New(KindUnavailable, "service_unavailable", "일시적으로 요청을 처리할 수 없습니다")
+	errTimeout        = New(KindUnavailable, "request_timeout", "요청 처리 시간이 초과되었습니다")
+	errCanceled       = New(KindCanceled, "request_canceled", "요청이 취소되었습니다")
+	errInternal       = New(KindInternal, "internal_error", "요청을 처리하는 중 오류가 발생하였습니다")
+)
+
+// Error는 도메인 오류입니다.
+//
+// errors.Is(err, target) 는 target 에 Code 가 있으면 Code 로, 없으면 Kind 로 비교합니다.
+// 따라서 WithDetail, Wrap 으로 만든 복사본도 원래 오류와 같은 것으로 취급됩니다.
+type Error struct {
+	Kind Kind
+	// 클라이언트가 분기에 사용할 수 있는 고정 코드 (예: user_not_found)
+	Code string
+	// 사용자에게 보여줄 메세지
+	Message string
+	// 응답에 포함해도 되는 추가 정보
+	Detail string
+	// 원인, 응답에는 포함되지 않습니다.
+	Err error
+}
+
+// New는 새로운 오류를 생성합니다.
+//
+// 매개 변수
+//   - kind: 오류 분류
+//   - code: 고정 코드
+//   - message: 사용자에게 보여줄 메세지
+func New(kind Kind, code, message string) *Error {
+	return &Error{Kind: kind, Code: code, Message: message}
+}
+
+// Unavailable은 의존하는 서비스에 연결할 수 없어 발생한 오류를 감쌉니다.
+func Unavailable(err error) *Error {
+	return errUnavailable.Wrap(err)
+}
+
+// Canceled는 context 가 취소되었거나 deadline 이 지나 중단된 오류를 감쌉니다.
+// deadline 이 지난 경우는 재시도할 수 있도록 KindUnavailable 로 분류합니다.
+func Canceled(err error) *Error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return errTimeout.Wrap(err)
+	}
+	return errCanceled.Wrap(err)
+}
+
+// Internal은 분류되지 않은 오류를 내부 오류로 감쌉니다.
+func Internal(err error) *Error {
+	return errInternal.Wrap(err)
+}
+
+// From은 err 를 *Error 로 변환합니다.
+// context 취소는 Canceled 로, 그 외 *Error 가 아닌 오류는 내부 오류로 감쌉니다.
+func From(err error) *Error {
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return Canceled(err)
+	}
+	return Internal(err)
+}
+
+// WithDetail은 Detail 을 설정한 복사본을 반환합니다.
+func (e *Error) WithDetail(format string, args ...interface{}) *Error {
+	c := *e
+	c.Detail = fmt.Sprintf(format, args...)
+	return &c
+}
+
+// Wrap은 원인을 설정한 복사본을 반환합니다.
+func (e *Error) Wrap(err error) *Error {
+	c := *e
+	c.Err = err
+	return &c
+}
+
+func (e *Error) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = string(e.Kind)
+	}
+	if e.Detail != "" {
+		msg += ": " + e.Detail
+	}
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	return msg
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok {
+		return false
+	}
+	if t.Code != "" {
+		return t.Code == e.Code
+	}
+	return t.Kind == e.Kind
+}
@@ -0,0 +1,62 @@
+// buildinfo 패키지는 실행 중인 서버의 빌드 정보를 제공합니다.
+//
+// 릴리스 빌드에서는 ldflags 로 값을 지정합니다.
+//
+//	go build -ldflags "-X github.com/B-Bridger/server/buildinfo.Commit=$(git rev-parse HEAD) -X github.com/B-Bridger/server/buildinfo.BuildTime=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+//
+// 지정하지 않으면 go build 가 기록한 VCS 정보를 사용합니다.
+package buildinfo
+
+import (
+	"runtime"
+	"runtime/debug"
+)
+
+// ldflags 로 지정하는 값
+var (
+	Commit    string
+	BuildTime string
+)
+
+// Info는 빌드 정보입니다.
+type Info struct {
+	// git commit, 빌드 후 수정된 파일이 있으면 "-dirty" 가 붙습니다.
+	Commit    string
+	BuildTime string
+	GoVersion string
+}
+
+// Get은 빌드 정보를 반환합니다. 알 수 없는 값은 "unknown" 입니다.
+func Get() Info {
+	info := Info{Commit: Commit, BuildTime: BuildTime, GoVersion: runtime.Version()}
+
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		var revision, modified string
+		for _, s := range bi.Settings {
+			switch s.Key {
+			case "vcs.revision":
+				revision = s.Value
+			case "vcs.modified":
+				modified = s.Value
+			case "vcs.time":
+				if info.BuildTime == "" {
+					info.BuildTime = s.Value
+				}
+			}
+		}
+		if info.Commit == "" && revision != "" {
+			info.Commit = revision
+			if modified == "true" {
+				info.Commit += "-dirty"
+			}
+		}
+	}
+
+	if info.Commit == "" {
+		info.Commit = "unknown"
+	}
+	if info.BuildTime == "" {
+		info.BuildTime = "unknown"
+	}
+	return info
+}
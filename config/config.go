@@ -0,0 +1,423 @@
+// config 패키지는 서버 설정을 불러오고 검증합니다.
+//
+// 설정은 서버 시작 시 한 번만 불러오며, 다음 순서로 덮어씁니다.
+//  1. 기본값
+//  2. YAML 설정 파일 (CONFIG_FILE, 지정하지 않으면 config.yaml 이 있을 때만)
+//  3. 환경변수 (.env 파일이 있으면 먼저 불러옵니다)
+//
+// 불러온 설정은 main 에서 middleware, service, database 에 주입합니다.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
+)
+
+// 지원하는 데이터베이스
+const (
+	DriverMySQL  = "mysql"
+	DriverSQLite = "sqlite"
+)
+
+// 지원하는 번역기
+const (
+	TranslationOpenAI = "openai"
+	TranslationLocal  = "local"
+)
+
+const (
+	// CONFIG_FILE 을 지정하지 않았을 때 사용하는 설정 파일, 없으면 무시합니다.
+	defaultConfigFile = "config.yaml"
+	// HS256 서명에 사용하는 JWT 비밀 키의 최소 길이 (byte)
+	MinSecretLength = 32
+	// 재시도 가능한 OpenAI 오류의 기본 최대 재시도 횟수
+	defaultOpenAIMaxRetries = 3
+)
+
+// Config는 서버 설정입니다.
+type Config struct {
+	Server      Server      `yaml:"server"`
+	Auth        Auth        `yaml:"auth"`
+	Database    Database    `yaml:"database"`
+	Translation Translation `yaml:"translation"`
+	FCM         FCM         `yaml:"fcm"`
+	Log         Log         `yaml:"log"`
+	Tracing     Tracing     `yaml:"tracing"`
+}
+
+// Server는 HTTP 서버 설정입니다.
+type Server struct {
+	// SERVER_PORT
+	Port string `yaml:"port"`
+	// SERVER_READ_HEADER_TIMEOUT, SERVER_READ_TIMEOUT, SERVER_WRITE_TIMEOUT, SERVER_IDLE_TIMEOUT (예: 30s), 0 이면 제한하지 않습니다.
+	// WebSocket 연결은 연결 후 hub 가 별도로 제한합니다.
+	ReadHeaderTimeout time.Duration `yaml:"readHeaderTimeout"`
+	ReadTimeout       time.Duration `yaml:"readTimeout"`
+	WriteTimeout      time.Duration `yaml:"writeTimeout"`
+	IdleTimeout       time.Duration `yaml:"idleTimeout"`
+	// SERVER_SHUTDOWN_TIMEOUT, 종료 신호를 받은 뒤 처리 중인 요청과 연결을 기다리는 시간
+	ShutdownTimeout time.Duration `yaml:"shutdownTimeout"`
+	// SERVER_SHUTDOWN_DELAY, 종료 신호를 받은 뒤 readiness 를 실패로 바꾸고 새로운 연결을 계속 받는 시간
+	// 로드 밸런서가 이 인스턴스를 제외할 시간을 줍니다. (ShutdownTimeout 에 포함되지 않습니다)
+	ShutdownDelay time.Duration `yaml:"shutdownDelay"`
+	// SERVER_REQUEST_TIMEOUT, 요청 하나를 처리하는 최대 시간, 0 이면 제한하지 않습니다.
+	// 시간이 지나면 진행 중인 쿼리와 번역 요청이 취소됩니다. WebSocket 연결에는 적용하지 않습니다.
+	RequestTimeout time.Duration `yaml:"requestTimeout"`
+	// SERVER_ALLOWED_ORIGINS (쉼표로 구분, 예: https://app.bridger.io), WebSocket 연결을 허용하는 Origin
+	// 비어 있으면 서버와 같은 호스트의 Origin 만 허용하고, "*" 이면 모든 Origin 을 허용합니다.
+	AllowedOrigins []string `yaml:"allowedOrigins"`
+}
+
+// Auth는 인증 설정입니다.
+type Auth struct {
+	// SECRET, access token 서명에 사용하는 비밀 키
+	Secret string `yaml:"secret"`
+}
+
+// Database는 데이터베이스 설정입니다.
+type Database struct {
+	// DB_DRIVER (mysql, sqlite)
+	Driver string `yaml:"driver"`
+	// DB_PATH, sqlite 파일 경로 (":memory:" 이면 in-memory)
+	Path string `yaml:"path"`
+	// DB_USER, DB_PASSWORD, DB_HOST, DB_PORT, DB_NAME (mysql)
+	User     string `yaml:"user"`
+	Password string `yaml:"password"`
+	Host     string `yaml:"host"`
+	Port     string `yaml:"port"`
+	Name     string `yaml:"name"`
+	// MIGRATE_ON_START, false 이면 서버 시작 시 마이그레이션을 적용하지 않습니다.
+	MigrateOnStart bool `yaml:"migrateOnStart"`
+}
+
+// Translation은 번역기 설정입니다.
+type Translation struct {
+	// TRANSLATION_PROVIDER (openai, local), 지정하지 않으면 OpenAI API 키가 있을 때 openai
+	// local 은 실제 번역을 하지 않으므로 명시적으로 지정해야 하며, 둘 다 없으면 시작을 거부합니다.
+	Provider string `yaml:"provider"`
+	OpenAI   OpenAI `yaml:"openai"`
+}
+
+// OpenAI는 OpenAI 번역기 설정입니다.
+type OpenAI struct {
+	// OPENAI_API_KEY
+	APIKey string `yaml:"apiKey"`
+	// OPENAI_MODEL
+	Model string `yaml:"model"`
+	// OPENAI_BASE_URL
+	BaseURL string `yaml:"baseURL"`
+	// OPENAI_TIMEOUT (예: 30s), 0 이면 번역기 기본값
+	Timeout time.Duration `yaml:"timeout"`
+	// OPENAI_MAX_RETRIES
+	MaxRetries int `yaml:"maxRetries"`
+}
+
+// FCM은 푸시 알림 설정입니다. CredentialsFile 이 없으면 푸시 알림을 보내지 않습니다.
+type FCM struct {
+	// FCM_CREDENTIALS_FILE 또는 GOOGLE_APPLICATION_CREDENTIALS, 서비스 계정 키 파일 경로
+	CredentialsFile string `yaml:"credentialsFile"`
+	// FCM_PROJECT_ID, FCM_BASE_URL, FCM_TOKEN_URL
+	ProjectID string `yaml:"projectID"`
+	BaseURL   string `yaml:"baseURL"`
+	TokenURL  string `yaml:"tokenURL"`
+}
+
+// 지원하는 로그 형식
+const (
+	LogFormatJSON = "json"
+	LogFormatText = "text"
+)
+
+// Log는 로그 설정입니다.
+type Log struct {
+	// LOG_LEVEL (debug, info, warn, error)
+	Level string `yaml:"level"`
+	// LOG_FORMAT (json, text), 로컬 개발 시에는 text 가 읽기 쉽습니다.
+	Format string `yaml:"format"`
+}
+
+// 지원하는 trace exporter
+const (
+	TracingNone   = "none"
+	TracingOTLP   = "otlp"
+	TracingStdout = "stdout"
+)
+
+// Tracing은 OpenTelemetry trace 설정입니다.
+type Tracing struct {
+	// TRACING_EXPORTER (none, otlp, stdout), none 이면 span 을 내보내지 않습니다.
+	Exporter string `yaml:"exporter"`
+	// OTEL_EXPORTER_OTLP_ENDPOINT, OTLP/HTTP collector 주소 (예: http://localhost:4318), 지정하지 않으면 http://localhost:4318
+	Endpoint string `yaml:"endpoint"`
+	// OTEL_SERVICE_NAME
+	ServiceName string `yaml:"serviceName"`
+	// TRACING_SAMPLE_RATIO, 요청을 기록하는 비율 (0 ~ 1), 상위 서비스가 sampling 여부를 정한 요청은 그대로 따릅니다.
+	SampleRatio float64 `yaml:"sampleRatio"`
+}
+
+// Default는 기본 설정을 반환합니다.
+func Default() *Config {
+	return &Config{
+		Server: Server{
+			Port:              "8080",
+			ReadHeaderTimeout: 10 * time.Second,
+			ReadTimeout:       30 * time.Second,
+			// 메세지 전송은 번역 API 응답을 기다리므로 번역기의 재시도 시간보다 길게 둡니다.
+			WriteTimeout:    2 * time.Minute,
+			IdleTimeout:     2 * time.Minute,
+			ShutdownTimeout: 30 * time.Second,
+			// 응답을 작성할 시간이 남도록 WriteTimeout 보다 짧게 둡니다.
+			RequestTimeout: time.Minute,
+		},
+		Database: Database{Driver: DriverMySQL, Path: "bridger.db", MigrateOnStart: true},
+		Translation: Translation{
+			OpenAI: OpenAI{MaxRetries: defaultOpenAIMaxRetries},
+		},
+		Log:     Log{Level: "info", Format: LogFormatJSON},
+		Tracing: Tracing{Exporter: TracingNone, ServiceName: "bridger", SampleRatio: 1},
+	}
+}
+
+// Load는 설정을 불러오고 검증합니다.
+//
+// 반환 값
+//   - *Config: 불러온 설정
+//   - error: 파일을 읽을 수 없거나 형식, 값이 올바르지 않으면 error 메세지
+func Load() (*Config, error) {
+	if err := godotenv.Load(); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf(".env 파일을 읽을 수 없습니다: %v", err)
+	}
+
+	cfg := Default()
+
+	file, required := os.LookupEnv("CONFIG_FILE")
+	if !required || file == "" {
+		file, required = defaultConfigFile, false
+	}
+	if err := cfg.loadFile(file, required); err != nil {
+		return nil, err
+	}
+	if err := cfg.loadEnv(); err != nil {
+		return nil, err
+	}
+
+	if cfg.Translation.Provider == "" && cfg.Translation.OpenAI.APIKey != "" {
+		cfg.Translation.Provider = TranslationOpenAI
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// loadFile은 YAML 설정 파일을 불러옵니다. required 가 아니면 파일이 없어도 무시합니다.
+func (c *Config) loadFile(path string, required bool) error {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) && !required {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("설정 파일을 읽을 수 없습니다: %v", err)
+	}
+
+	if err := yaml.Unmarshal(data, c); err != nil {
+		return fmt.Errorf("설정 파일 %s 형식이 올바르지 않습니다: %v", path, err)
+	}
+	return nil
+}
+
+// loadEnv는 환경변수가 있으면 설정을 덮어씁니다.
+func (c *Config) loadEnv() error {
+	fields := map[string]*string{
+		"SERVER_PORT":          &c.Server.Port,
+		"SECRET":               &c.Auth.Secret,
+		"DB_DRIVER":            &c.Database.Driver,
+		"DB_PATH":              &c.Database.Path,
+		"DB_USER":              &c.Database.User,
+		"DB_PASSWORD":          &c.Database.Password,
+		"DB_HOST":              &c.Database.Host,
+		"DB_PORT":              &c.Database.Port,
+		"DB_NAME":              &c.Database.Name,
+		"TRANSLATION_PROVIDER": &c.Translation.Provider,
+		"OPENAI_API_KEY":       &c.Translation.OpenAI.APIKey,
+		"OPENAI_MODEL":         &c.Translation.OpenAI.Model,
+		"OPENAI_BASE_URL":      &c.Translation.OpenAI.BaseURL,
+		// GOOGLE_APPLICATION_CREDENTIALS 보다 FCM_CREDENTIALS_FILE 이 우선합니다.
+		"GOOGLE_APPLICATION_CREDENTIALS": &c.FCM.CredentialsFile,
+		"FCM_PROJECT_ID":                 &c.FCM.ProjectID,
+		"FCM_BASE_URL":                   &c.FCM.BaseURL,
+		"FCM_TOKEN_URL":                  &c.FCM.TokenURL,
+		"LOG_LEVEL":                      &c.Log.Level,
+		"LOG_FORMAT":                     &c.Log.Format,
+		"TRACING_EXPORTER":               &c.Tracing.Exporter,
+		"OTEL_EXPORTER_OTLP_ENDPOINT":    &c.Tracing.Endpoint,
+		"OTEL_SERVICE_NAME":              &c.Tracing.ServiceName,
+	}
+	for key, field := range fields {
+		if v := os.Getenv(key); v != "" {
+			*field = v
+		}
+	}
+	if v := os.Getenv("FCM_CREDENTIALS_FILE"); v != "" {
+		c.FCM.CredentialsFile = v
+	}
+	if v := os.Getenv("SERVER_ALLOWED_ORIGINS"); v != "" {
+		c.Server.AllowedOrigins = nil
+		for _, origin := range strings.Split(v, ",") {
+			if origin = strings.TrimSpace(origin); origin != "" {
+				c.Server.AllowedOrigins = append(c.Server.AllowedOrigins, origin)
+			}
+		}
+	}
+
+	var errs []error
+	if v := os.Getenv("MIGRATE_ON_START"); v != "" {
+		migrate, err := strconv.ParseBool(v)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("MIGRATE_ON_START 형식이 올바르지 않습니다: %v", err))
+		}
+		c.Database.MigrateOnStart = migrate
+	}
+	durations := map[string]*time.Duration{
+		"SERVER_READ_HEADER_TIMEOUT": &c.Server.ReadHeaderTimeout,
+		"SERVER_READ_TIMEOUT":        &c.Server.ReadTimeout,
+		"SERVER_WRITE_TIMEOUT":       &c.Server.WriteTimeout,
+		"SERVER_IDLE_TIMEOUT":        &c.Server.IdleTimeout,
+		"SERVER_SHUTDOWN_TIMEOUT":    &c.Server.ShutdownTimeout,
+		"SERVER_SHUTDOWN_DELAY":      &c.Server.ShutdownDelay,
+		"SERVER_REQUEST_TIMEOUT":     &c.Server.RequestTimeout,
+		"OPENAI_TIMEOUT":             &c.Translation.OpenAI.Timeout,
+	}
+	for key, field := range durations {
+		if v := os.Getenv(key); v != "" {
+			d, err := time.ParseDuration(v)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s 형식이 올바르지 않습니다: %v", key, err))
+			}
+			*field = d
+		}
+	}
+	if v := os.Getenv("OPENAI_MAX_RETRIES"); v != "" {
+		retries, err := strconv.Atoi(v)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("OPENAI_MAX_RETRIES 형식이 올바르지 않습니다: %v", err))
+		}
+		c.Translation.OpenAI.MaxRetries = retries
+	}
+	if v := os.Getenv("TRACING_SAMPLE_RATIO"); v != "" {
+		ratio, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("TRACING_SAMPLE_RATIO 형식이 올바르지 않습니다: %v", err))
+		}
+		c.Tracing.SampleRatio = ratio
+	}
+	return errors.Join(errs...)
+}
+
+// Validate는 설정 값을 검증합니다. 잘못된 값이 여러 개이면 모두 모아 반환합니다.
+func (c *Config) Validate() error {
+	var errs []error
+
+	if port, err := strconv.Atoi(c.Server.Port); err != nil || port < 1 || port > 65535 {
+		errs = append(errs, fmt.Errorf("서버 포트가 올바르지 않습니다: %q", c.Server.Port))
+	}
+	if c.Server.ReadHeaderTimeout < 0 || c.Server.ReadTimeout < 0 || c.Server.WriteTimeout < 0 || c.Server.IdleTimeout < 0 || c.Server.ShutdownDelay < 0 || c.Server.RequestTimeout < 0 {
+		errs = append(errs, errors.New("서버 timeout 은 0 이상이어야 합니다"))
+	}
+	if c.Server.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("SERVER_SHUTDOWN_TIMEOUT 은 0 보다 커야 합니다"))
+	}
+	for _, origin := range c.Server.AllowedOrigins {
+		if origin == "*" {
+			continue
+		}
+		if u, err := url.Parse(origin); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || (u.Path != "" && u.Path != "/") {
+			errs = append(errs, fmt.Errorf("SERVER_ALLOWED_ORIGINS 는 http(s)://호스트[:포트] 형식이어야 합니다: %q", origin))
+		}
+	}
+
+	switch {
+	case c.Auth.Secret == "":
+		errs = append(errs, errors.New("JWT 비밀 키(SECRET)가 설정되지 않았습니다"))
+	case len(c.Auth.Secret) < MinSecretLength:
+		errs = append(errs, fmt.Errorf("JWT 비밀 키(SECRET)는 %d자 이상이어야 합니다", MinSecretLength))
+	}
+
+	switch c.Database.Driver {
+	case DriverMySQL:
+		var missing []string
+		for key, value := range map[string]string{
+			"DB_USER": c.Database.User, "DB_PASSWORD": c.Database.Password, "DB_HOST": c.Database.Host, "DB_PORT": c.Database.Port, "DB_NAME": c.Database.Name,
+		} {
+			if value == "" {
+				missing = append(missing, key)
+			}
+		}
+		if len(missing) > 0 {
+			sort.Strings(missing)
+			errs = append(errs, fmt.Errorf("%s 설정이 존재하지 않습니다", strings.Join(missing, ", ")))
+		}
+	case DriverSQLite:
+		if c.Database.Path == "" {
+			errs = append(errs, errors.New("DB_PATH 설정이 존재하지 않습니다"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("지원하지 않는 DB_DRIVER 입니다: %s", c.Database.Driver))
+	}
+
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(c.Log.Level)); err != nil {
+		errs = append(errs, fmt.Errorf("지원하지 않는 LOG_LEVEL 입니다: %s", c.Log.Level))
+	}
+	if c.Log.Format != LogFormatJSON && c.Log.Format != LogFormatText {
+		errs = append(errs, fmt.Errorf("지원하지 않는 LOG_FORMAT 입니다: %s", c.Log.Format))
+	}
+
+	switch c.Tracing.Exporter {
+	case TracingNone, TracingStdout:
+	case TracingOTLP:
+		if c.Tracing.Endpoint != "" {
+			if u, err := url.Parse(c.Tracing.Endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				errs = append(errs, fmt.Errorf("OTEL_EXPORTER_OTLP_ENDPOINT 는 http(s) 주소여야 합니다: %q", c.Tracing.Endpoint))
+			}
+		}
+	default:
+		errs = append(errs, fmt.Errorf("지원하지 않는 TRACING_EXPORTER 입니다: %s", c.Tracing.Exporter))
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		errs = append(errs, errors.New("TRACING_SAMPLE_RATIO 는 0 이상 1 이하여야 합니다"))
+	}
+
+	switch c.Translation.Provider {
+	case TranslationOpenAI:
+		if c.Translation.OpenAI.APIKey == "" {
+			errs = append(errs, errors.New("OPENAI_API_KEY 설정이 존재하지 않습니다"))
+		}
+	case TranslationLocal:
+	case "":
+		// API 키를 빠뜨린 운영 서버가 번역 없이 실행되지 않도록 local 로 대체하지 않습니다.
+		errs = append(errs, errors.New("TRANSLATION_PROVIDER 또는 OPENAI_API_KEY 설정이 존재하지 않습니다 (번역 없이 실행하려면 TRANSLATION_PROVIDER=local)"))
+	default:
+		errs = append(errs, fmt.Errorf("지원하지 않는 TRANSLATION_PROVIDER 입니다: %s", c.Translation.Provider))
+	}
+	if c.Translation.OpenAI.Timeout < 0 || c.Translation.OpenAI.MaxRetries < 0 {
+		errs = append(errs, errors.New("OPENAI_TIMEOUT, OPENAI_MAX_RETRIES 는 0 이상이어야 합니다"))
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("설정이 올바르지 않습니다: %w", errors.Join(errs...))
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+// clearEnv는 설정 환경변수를 모두 비웁니다.
+func clearEnv(t *testing.T) {
+	t.Helper()
+	t.Chdir(t.TempDir())
+	for _, key := range []string{
+		"CONFIG_FILE", "SERVER_PORT", "SECRET",
+		"SERVER_READ_HEADER_TIMEOUT", "SERVER_READ_TIMEOUT", "SERVER_WRITE_TIMEOUT", "SERVER_IDLE_TIMEOUT", "SERVER_SHUTDOWN_TIMEOUT", "SERVER_SHUTDOWN_DELAY", "SERVER_REQUEST_TIMEOUT", "SERVER_ALLOWED_ORIGINS",
+		"DB_DRIVER", "DB_PATH", "DB_USER", "DB_PASSWORD", "DB_HOST", "DB_PORT", "DB_NAME", "MIGRATE_ON_START",
+		"TRANSLATION_PROVIDER", "OPENAI_API_KEY", "OPENAI_MODEL", "OPENAI_BASE_URL", "OPENAI_TIMEOUT", "OPENAI_MAX_RETRIES",
+		"FCM_CREDENTIALS_FILE", "GOOGLE_APPLICATION_CREDENTIALS", "FCM_PROJECT_ID", "FCM_BASE_URL", "FCM_TOKEN_URL",
+		"LOG_LEVEL", "LOG_FORMAT",
+		"TRACING_EXPORTER", "OTEL_EXPORTER_OTLP_ENDPOINT", "OTEL_SERVICE_NAME", "TRACING_SAMPLE_RATIO",
+	} {
+		t.Setenv(key, "")
+	}
+}
+
+func TestLoadEnv(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("SECRET", testSecret)
+	t.Setenv("DB_DRIVER", DriverSQLite)
+	t.Setenv("MIGRATE_ON_START", "false")
+	t.Setenv("OPENAI_API_KEY", "sk-test")
+	t.Setenv("OPENAI_TIMEOUT", "5s")
+	t.Setenv("SERVER_SHUTDOWN_TIMEOUT", "1m")
+	t.Setenv("SERVER_REQUEST_TIMEOUT", "0")
+	t.Setenv("SERVER_ALLOWED_ORIGINS", "https://app.bridger.io, http://localhost:3000")
+	t.Setenv("GOOGLE_APPLICATION_CREDENTIALS", "google.json")
+	t.Setenv("FCM_CREDENTIALS_FILE", "fcm.json")
+	t.Setenv("TRACING_EXPORTER", TracingOTLP)
+	t.Setenv("TRACING_SAMPLE_RATIO", "0.25")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Server.Port != "8080" || cfg.Database.Path != "bridger.db" {
+		t.Fatalf("기본값이 적용되지 않았습니다: %+v", cfg)
+	}
+	if cfg.Server.ShutdownTimeout != time.Minute || cfg.Server.ReadHeaderTimeout != 10*time.Second || cfg.Server.RequestTimeout != 0 {
+		t.Fatalf("server = %+v", cfg.Server)
+	}
+	if len(cfg.Server.AllowedOrigins) != 2 || cfg.Server.AllowedOrigins[1] != "http://localhost:3000" {
+		t.Fatalf("allowedOrigins = %q", cfg.Server.AllowedOrigins)
+	}
+	if cfg.Database.MigrateOnStart {
+		t.Fatal("MIGRATE_ON_START=false 가 적용되지 않았습니다")
+	}
+	if cfg.Translation.Provider != TranslationOpenAI || cfg.Translation.OpenAI.Timeout != 5*time.Second {
+		t.Fatalf("translation = %+v", cfg.Translation)
+	}
+	if cfg.FCM.CredentialsFile != "fcm.json" {
+		t.Fatalf("credentialsFile = %q, FCM_CREDENTIALS_FILE 이 우선해야 합니다", cfg.FCM.CredentialsFile)
+	}
+	if cfg.Tracing.Exporter != TracingOTLP || cfg.Tracing.SampleRatio != 0.25 || cfg.Tracing.ServiceName != "bridger" {
+		t.Fatalf("tracing = %+v", cfg.Tracing)
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	clearEnv(t)
+	path := filepath.Join(t.TempDir(), "bridger.yaml")
+	data := "server:\n  port: \"9090\"\nauth:\n  secret: " + testSecret + "\ndatabase:\n  driver: sqlite\n  path: file.db\ntranslation:\n  openai:\n    timeout: 10s\n"
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("CONFIG_FILE", path)
+	t.Setenv("DB_PATH", "env.db")
+	t.Setenv("TRANSLATION_PROVIDER", TranslationLocal)
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Server.Port != "9090" || cfg.Auth.Secret != testSecret {
+		t.Fatalf("설정 파일이 적용되지 않았습니다: %+v", cfg)
+	}
+	if cfg.Database.Path != "env.db" {
+		t.Fatalf("path = %q, 환경변수가 설정 파일보다 우선해야 합니다", cfg.Database.Path)
+	}
+	if cfg.Translation.OpenAI.Timeout != 10*time.Second {
+		t.Fatalf("timeout = %v, want 10s", cfg.Translation.OpenAI.Timeout)
+	}
+	if cfg.Translation.Provider != TranslationLocal {
+		t.Fatalf("provider = %q, want %q", cfg.Translation.Provider, TranslationLocal)
+	}
+
+	// 번역기를 지정하지 않고 API 키도 없으면 local 로 대체하지 않고 시작을 거부합니다.
+	t.Setenv("TRANSLATION_PROVIDER", "")
+	if _, err := Load(); err == nil || !strings.Contains(err.Error(), "TRANSLATION_PROVIDER") {
+		t.Fatalf("err = %v, want TRANSLATION_PROVIDER", err)
+	}
+
+	// 지정한 설정 파일이 없으면 시작을 거부합니다.
+	t.Setenv("CONFIG_FILE", filepath.Join(t.TempDir(), "missing.yaml"))
+	if _, err := Load(); err == nil {
+		t.Fatal("설정 파일이 없는데 error 가 없습니다")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	valid := func() *Config {
+		cfg := Default()
+		cfg.Auth.Secret = testSecret
+		cfg.Database.Driver = DriverSQLite
+		cfg.Translation.Provider = TranslationLocal
+		return cfg
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*Config)
+		want   string
+	}{
+		{name: "valid", modify: func(*Config) {}},
+		{name: "empty secret", modify: func(c *Config) { c.Auth.Secret = "" }, want: "SECRET"},
+		{name: "short secret", modify: func(c *Config) { c.Auth.Secret = "dev" }, want: "SECRET"},
+		{name: "invalid port", modify: func(c *Config) { c.Server.Port = "http" }, want: "포트"},
+		{name: "negative request timeout", modify: func(c *Config) { c.Server.RequestTimeout = -time.Second }, want: "timeout"},
+		{name: "no shutdown timeout", modify: func(c *Config) { c.Server.ShutdownTimeout = 0 }, want: "SERVER_SHUTDOWN_TIMEOUT"},
+		{name: "invalid allowed origin", modify: func(c *Config) { c.Server.AllowedOrigins = []string{"app.bridger.io"} }, want: "SERVER_ALLOWED_ORIGINS"},
+		{name: "missing mysql", modify: func(c *Config) { c.Database.Driver = DriverMySQL; c.Database.User = "bridger" }, want: "DB_HOST, DB_NAME, DB_PASSWORD, DB_PORT"},
+		{name: "unknown driver", modify: func(c *Config) { c.Database.Driver = "postgres" }, want: "DB_DRIVER"},
+		{name: "openai without key", modify: func(c *Config) { c.Translation.Provider = TranslationOpenAI }, want: "OPENAI_API_KEY"},
+		{name: "unknown log level", modify: func(c *Config) { c.Log.Level = "verbose" }, want: "LOG_LEVEL"},
+		{name: "unknown log format", modify: func(c *Config) { c.Log.Format = "xml" }, want: "LOG_FORMAT"},
+		{name: "unknown trace exporter", modify: func(c *Config) { c.Tracing.Exporter = "jaeger" }, want: "TRACING_EXPORTER"},
+		{name: "invalid otlp endpoint", modify: func(c *Config) { c.Tracing.Exporter = TracingOTLP; c.Tracing.Endpoint = "localhost:4318" }, want: "OTEL_EXPORTER_OTLP_ENDPOINT"},
+		{name: "invalid sample ratio", modify: func(c *Config) { c.Tracing.SampleRatio = 1.5 }, want: "TRACING_SAMPLE_RATIO"},
+		{name: "no provider", modify: func(c *Config) { c.Translation.Provider = "" }, want: "TRANSLATION_PROVIDER"},
+		{name: "unknown provider", modify: func(c *Config) { c.Translation.Provider = "deepl" }, want: "TRANSLATION_PROVIDER"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := valid()
+			tt.modify(cfg)
+			err := cfg.Validate()
+			if tt.want == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("err = %v, want %q", err, tt.want)
+			}
+		})
+	}
+
+	// 잘못된 값은 모두 한 번에 보고합니다.
+	cfg := valid()
+	cfg.Auth.Secret = ""
+	cfg.Database.Driver = "postgres"
+	err := cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "SECRET") || !strings.Contains(err.Error(), "DB_DRIVER") {
+		t.Fatalf("err = %v", err)
+	}
+}
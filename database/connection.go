@@ -2,39 +2,35 @@ package database
 
 import (
 	"fmt"
-	"os"
 
-	"github.com/joho/godotenv"
+	"github.com/B-Bridger/server/config"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 )
 
-func getEnv(key string) string {
-	value := os.Getenv(key)
-	if value == "" {
-		panic(fmt.Sprintf("%s 환경변수가 존재하지 않습니다.", key))
+// Connection은 설정의 Driver(mysql, sqlite)에 따라 데이터베이스에 연결합니다.
+// 설정 값은 config.Load 에서 검증합니다.
+//   - mysql: User, Password, Host, Port, Name
+//   - sqlite: Path (파일 경로, ":memory:" 이면 in-memory 데이터베이스)
+//
+// 매개 변수
+//   - cfg: 데이터베이스 설정
+//
+// 반환 값
+//   - *gorm.DB: 연결된 데이터베이스
+//   - error: 연결 실패 시 error 메세지
+func Connection(cfg config.Database) (*gorm.DB, error) {
+	switch cfg.Driver {
+	case config.DriverMySQL:
+		return gorm.Open(mysql.Open(mysqlDSN(cfg)), &gorm.Config{TranslateError: true})
+	case config.DriverSQLite:
+		return openSQLite(cfg.Path)
+	default:
+		return nil, fmt.Errorf("지원하지 않는 DB_DRIVER 입니다: %s", cfg.Driver)
 	}
-	return value
 }
 
-func Connection() (*gorm.DB, error) {
-	err := godotenv.Load()
-	if err != nil {
-		return nil, fmt.Errorf(".env 파일을 찾을 수 없습니다: %v", err)
-	}
-
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=Local",
-		getEnv("DB_USER"),
-		getEnv("DB_PASSWORD"),
-		getEnv("DB_HOST"),
-		getEnv("DB_PORT"),
-		getEnv("DB_NAME"),
-	)
-
-	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{})
-	if err != nil {
-		return nil, err
-	}
-
-	return db, nil
+// mysqlDSN은 MariaDB(MySQL) 접속 정보를 만듭니다.
+func mysqlDSN(cfg config.Database) string {
+	return fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=Local", cfg.User, cfg.Password, cfg.Host, cfg.Port, cfg.Name)
 }
@@ -0,0 +1,71 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/B-Bridger/server/config"
+	"github.com/B-Bridger/server/database/migration"
+)
+
+func TestConnectionSQLite(t *testing.T) {
+	db, err := Connection(config.Database{Driver: config.DriverSQLite, Path: ":memory:"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name := db.Dialector.Name(); name != config.DriverSQLite {
+		t.Fatalf("dialector = %q, want %q", name, config.DriverSQLite)
+	}
+
+	migrator, err := migration.New(db, migration.All())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	// 상태 조회는 스키마를 변경하지 않아야 합니다.
+	if pending, err := migrator.Pending(ctx); err != nil || pending != len(migration.All()) {
+		t.Fatalf("pending = %d, err = %v, want %d", pending, err, len(migration.All()))
+	}
+	if db.Migrator().HasTable("schema_migrations") {
+		t.Fatal("상태 조회가 schema_migrations 테이블을 생성했습니다")
+	}
+
+	if _, err := migrator.Up(ctx); err != nil {
+		t.Fatalf("up: %v", err)
+	}
+	for _, table := range []string{"users", "chat_rooms", "chat_room_members", "messages", "glossary_terms", "devices", "email_changes", "company_invitations"} {
+		if !db.Migrator().HasTable(table) {
+			t.Fatalf("%s 테이블이 없습니다", table)
+		}
+	}
+	for _, column := range []string{"ownerUserID", "title", "defaultLanguage"} {
+		if !db.Migrator().HasColumn("chat_rooms", column) {
+			t.Fatalf("chat_rooms.%s 열이 없습니다", column)
+		}
+	}
+
+	// 모두 되돌린 뒤 다시 적용할 수 있어야 합니다.
+	if _, err := migrator.Down(ctx, len(migration.All())); err != nil {
+		t.Fatalf("down: %v", err)
+	}
+	if _, err := migrator.Up(ctx); err != nil {
+		t.Fatalf("up again: %v", err)
+	}
+	if pending, err := migrator.Pending(ctx); err != nil || pending != 0 {
+		t.Fatalf("pending = %d, err = %v", pending, err)
+	}
+
+	// 상태 조회도 요청의 context 를 따릅니다.
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := migrator.Status(canceled); err == nil {
+		t.Fatal("취소된 context 로 상태를 조회했습니다")
+	}
+}
+
+func TestConnectionUnknownDriver(t *testing.T) {
+	if _, err := Connection(config.Database{Driver: "postgres"}); err == nil {
+		t.Fatal("지원하지 않는 DB_DRIVER 인데 error 가 없습니다")
+	}
+}
@@ -0,0 +1,134 @@
+package migration
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 0001 시점의 테이블 구조입니다. model 패키지의 구조체가 바뀌어도 이 마이그레이션의 결과가
+// 달라지지 않도록 별도의 구조체로 고정합니다.
+//
+// 기존에 AutoMigrate 로 만들어진 데이터베이스에서도 적용할 수 있도록 AutoMigrate 를 사용합니다.
+
+type user0001 struct {
+	UserID      string    `gorm:"column:userID;primaryKey;"`
+	Password    string    `gorm:"column:password"`
+	Name        string    `gorm:"column:name"`
+	Email       string    `gorm:"column:email;unique"`
+	CompanyID   string    `gorm:"column:companyID;index"`
+	CompanyRole string    `gorm:"column:companyRole"`
+	Language    string    `gorm:"column:language"`
+	Profile     string    `gorm:"column:profile"`
+	CreatedAt   time.Time `gorm:"column:createdAt;autoCreateTime"`
+	FcmToken    string    `gorm:"column:fcmToken"`
+}
+
+func (user0001) TableName() string { return "users" }
+
+type chatRoom0001 struct {
+	ChatRoomID    string    `gorm:"column:chatRoomID;primaryKey;"`
+	UserID        string    `gorm:"column:ownerUserID"`
+	LastMessage   string    `gorm:"column:lastMessage"`
+	LastMessageAt time.Time `gorm:"column:lastMessageAt"`
+	CreatedAt     time.Time `gorm:"column:createdAt;autoCreateTime"`
+}
+
+func (chatRoom0001) TableName() string { return "chat_rooms" }
+
+type message0001 struct {
+	MessageID  string    `gorm:"column:messageID;primaryKey;"`
+	ChatRoomID string    `gorm:"column:chatRoomID;index:idx_messages_chat_room_created,priority:1"`
+	UserID     string    `gorm:"column:senderUserID"`
+	Content    string    `gorm:"column:content;type:text"`
+	Language   string    `gorm:"column:language"`
+	CreatedAt  time.Time `gorm:"column:createdAt;autoCreateTime;index:idx_messages_chat_room_created,priority:2"`
+	UpdatedAt  time.Time `gorm:"column:updatedAt;autoUpdateTime"`
+}
+
+func (message0001) TableName() string { return "messages" }
+
+type messageTranslation0001 struct {
+	MessageID string    `gorm:"column:messageID;primaryKey"`
+	Language  string    `gorm:"column:language;primaryKey"`
+	Content   string    `gorm:"column:content;type:text"`
+	Provider  string    `gorm:"column:provider"`
+	CreatedAt time.Time `gorm:"column:createdAt;autoCreateTime"`
+}
+
+func (messageTranslation0001) TableName() string { return "message_translations" }
+
+type chatRoomMember0001 struct {
+	ChatRoomID string    `gorm:"column:chatRoomID;primaryKey"`
+	UserID     string    `gorm:"column:userID;primaryKey;index"`
+	Role       string    `gorm:"column:role"`
+	JoinedAt   time.Time `gorm:"column:joinedAt;autoCreateTime"`
+}
+
+func (chatRoomMember0001) TableName() string { return "chat_room_members" }
+
+type refreshToken0001 struct {
+	TokenID   string     `gorm:"column:tokenID;primaryKey;"`
+	UserID    string     `gorm:"column:userID;index"`
+	FamilyID  string     `gorm:"column:familyID;index"`
+	TokenHash string     `gorm:"column:tokenHash;size:64;uniqueIndex"`
+	ExpiresAt time.Time  `gorm:"column:expiresAt"`
+	RevokedAt *time.Time `gorm:"column:revokedAt"`
+	CreatedAt time.Time  `gorm:"column:createdAt;autoCreateTime"`
+}
+
+func (refreshToken0001) TableName() string { return "refresh_tokens" }
+
+type revokedToken0001 struct {
+	JTI       string    `gorm:"column:jti;primaryKey;size:64"`
+	ExpiresAt time.Time `gorm:"column:expiresAt;index"`
+	CreatedAt time.Time `gorm:"column:createdAt;autoCreateTime"`
+}
+
+func (revokedToken0001) TableName() string { return "revoked_tokens" }
+
+type company0001 struct {
+	CompanyID string    `gorm:"column:companyID;primaryKey;"`
+	Name      string    `gorm:"column:name"`
+	Domain    string    `gorm:"column:domain"`
+	CreatedAt time.Time `gorm:"column:createdAt;autoCreateTime"`
+}
+
+func (company0001) TableName() string { return "companies" }
+
+type chatRoomCompany0001 struct {
+	ChatRoomID string    `gorm:"column:chatRoomID;primaryKey"`
+	CompanyID  string    `gorm:"column:companyID;primaryKey;index"`
+	JoinedAt   time.Time `gorm:"column:joinedAt;autoCreateTime"`
+}
+
+func (chatRoomCompany0001) TableName() string { return "chat_room_companies" }
+
+// 생성 순서대로 나열하며, Down 은 역순으로 삭제합니다.
+var tables0001 = []interface{}{
+	&company0001{},
+	&user0001{},
+	&chatRoom0001{},
+	&chatRoomMember0001{},
+	&chatRoomCompany0001{},
+	&message0001{},
+	&messageTranslation0001{},
+	&refreshToken0001{},
+	&revokedToken0001{},
+}
+
+var m0001InitialSchema = Migration{
+	Version: 1,
+	Name:    "initial_schema",
+	Up: func(tx *gorm.DB) error {
+		return tx.AutoMigrate(tables0001...)
+	},
+	Down: func(tx *gorm.DB) error {
+		for i := len(tables0001) - 1; i >= 0; i-- {
+			if err := tx.Migrator().DropTable(tables0001[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
@@ -0,0 +1,19 @@
+package migration
+
+import "gorm.io/gorm"
+
+// 멤버 테이블 도입 이전에 생성된 채팅방의 소유자를 owner 멤버로 등록합니다.
+var m0002BackfillChatRoomOwners = Migration{
+	Version: 2,
+	Name:    "backfill_chat_room_owners",
+	Up: func(tx *gorm.DB) error {
+		return tx.Exec("INSERT INTO chat_room_members (chatRoomID, userID, role, joinedAt) " +
+			"SELECT chatRoomID, ownerUserID, 'owner', createdAt FROM chat_rooms cr " +
+			"WHERE NOT EXISTS (SELECT 1 FROM chat_room_members m WHERE m.chatRoomID = cr.chatRoomID AND m.userID = cr.ownerUserID)").
+			Error
+	},
+	// 데이터만 채우는 단계이므로 되돌릴 때는 아무것도 하지 않습니다.
+	Down: func(tx *gorm.DB) error {
+		return nil
+	},
+}
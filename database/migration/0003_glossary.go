@@ -0,0 +1,41 @@
+package migration
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 0003 시점의 용어집 테이블 구조입니다.
+
+type glossaryTerm0003 struct {
+	TermID         string    `gorm:"column:termID;primaryKey;"`
+	Scope          string    `gorm:"column:scope;size:16;uniqueIndex:idx_glossary_terms_source,priority:1"`
+	ScopeID        string    `gorm:"column:scopeID;size:64;uniqueIndex:idx_glossary_terms_source,priority:2"`
+	Source         string    `gorm:"column:source;size:255;uniqueIndex:idx_glossary_terms_source,priority:3"`
+	CaseSensitive  bool      `gorm:"column:caseSensitive"`
+	DoNotTranslate bool      `gorm:"column:doNotTranslate"`
+	CreatedAt      time.Time `gorm:"column:createdAt;autoCreateTime"`
+	UpdatedAt      time.Time `gorm:"column:updatedAt;autoUpdateTime"`
+}
+
+func (glossaryTerm0003) TableName() string { return "glossary_terms" }
+
+type glossaryTarget0003 struct {
+	TermID   string `gorm:"column:termID;primaryKey"`
+	Language string `gorm:"column:language;primaryKey;size:16"`
+	Target   string `gorm:"column:target;size:255"`
+}
+
+func (glossaryTarget0003) TableName() string { return "glossary_targets" }
+
+var m0003Glossary = Migration{
+	Version: 3,
+	Name:    "glossary",
+	Up: func(tx *gorm.DB) error {
+		return tx.AutoMigrate(&glossaryTerm0003{}, &glossaryTarget0003{})
+	},
+	Down: func(tx *gorm.DB) error {
+		return tx.Migrator().DropTable(&glossaryTarget0003{}, &glossaryTerm0003{})
+	},
+}
@@ -0,0 +1,32 @@
+package migration
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type chatRoomMember0004 struct {
+	ChatRoomID string    `gorm:"column:chatRoomID;primaryKey"`
+	UserID     string    `gorm:"column:userID;primaryKey;index"`
+	Role       string    `gorm:"column:role"`
+	Muted      bool      `gorm:"column:muted;default:false"`
+	JoinedAt   time.Time `gorm:"column:joinedAt;autoCreateTime"`
+}
+
+func (chatRoomMember0004) TableName() string { return "chat_room_members" }
+
+// 채팅방별 푸시 알림 끄기 설정을 추가합니다.
+var m0004ChatRoomMemberMuted = Migration{
+	Version: 4,
+	Name:    "chat_room_member_muted",
+	Up: func(tx *gorm.DB) error {
+		if tx.Migrator().HasColumn(&chatRoomMember0004{}, "Muted") {
+			return nil
+		}
+		return tx.Migrator().AddColumn(&chatRoomMember0004{}, "Muted")
+	},
+	Down: func(tx *gorm.DB) error {
+		return tx.Migrator().DropColumn(&chatRoomMember0004{}, "Muted")
+	},
+}
@@ -0,0 +1,97 @@
+package migration
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type device0005 struct {
+	DeviceID   string    `gorm:"column:deviceID;primaryKey;"`
+	UserID     string    `gorm:"column:userID;index"`
+	SessionID  string    `gorm:"column:sessionID;size:64;index"`
+	Platform   string    `gorm:"column:platform;size:16"`
+	PushToken  string    `gorm:"column:pushToken;size:255;uniqueIndex"`
+	AppVersion string    `gorm:"column:appVersion;size:32"`
+	Locale     string    `gorm:"column:locale;size:16"`
+	LastSeenAt time.Time `gorm:"column:lastSeenAt;index"`
+	CreatedAt  time.Time `gorm:"column:createdAt;autoCreateTime"`
+}
+
+func (device0005) TableName() string { return "devices" }
+
+// users.fcmToken 열만 다루기 위한 구조체입니다.
+type userFcmToken0005 struct {
+	UserID   string `gorm:"column:userID;primaryKey;"`
+	FcmToken string `gorm:"column:fcmToken"`
+}
+
+func (userFcmToken0005) TableName() string { return "users" }
+
+// 사용자당 하나였던 FCM 토큰을 devices 테이블로 옮기고 users.fcmToken 열을 제거합니다.
+// 옮겨진 기기는 로그인(session)과 연결되어 있지 않으므로, 앱이 다시 등록하면 새로운 로그인에 연결됩니다.
+var m0005Devices = Migration{
+	Version: 5,
+	Name:    "devices",
+	Up: func(tx *gorm.DB) error {
+		if err := tx.AutoMigrate(&device0005{}); err != nil {
+			return err
+		}
+		if !tx.Migrator().HasColumn(&userFcmToken0005{}, "FcmToken") {
+			return nil
+		}
+
+		var users []userFcmToken0005
+		if err := tx.Where("fcmToken <> ''").Find(&users).Error; err != nil {
+			return err
+		}
+
+		now := time.Now()
+		seen := make(map[string]struct{}, len(users))
+		devices := make([]device0005, 0, len(users))
+		for _, u := range users {
+			// 같은 토큰이 여러 사용자에게 남아 있으면 하나만 옮깁니다.
+			if _, ok := seen[u.FcmToken]; ok {
+				continue
+			}
+			seen[u.FcmToken] = struct{}{}
+			devices = append(devices, device0005{
+				DeviceID:   uuid.NewString(),
+				UserID:     u.UserID,
+				Platform:   "unknown",
+				PushToken:  u.FcmToken,
+				LastSeenAt: now,
+			})
+		}
+		if len(devices) > 0 {
+			if err := tx.CreateInBatches(&devices, 500).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Migrator().DropColumn(&userFcmToken0005{}, "FcmToken")
+	},
+	// 사용자마다 가장 최근에 사용된 기기의 토큰을 users.fcmToken 으로 되돌립니다.
+	Down: func(tx *gorm.DB) error {
+		if err := tx.Migrator().AddColumn(&userFcmToken0005{}, "FcmToken"); err != nil {
+			return err
+		}
+
+		var devices []device0005
+		if err := tx.Order("lastSeenAt").Find(&devices).Error; err != nil {
+			return err
+		}
+		latest := make(map[string]string)
+		for _, d := range devices {
+			latest[d.UserID] = d.PushToken
+		}
+		for userID, token := range latest {
+			if err := tx.Model(&userFcmToken0005{}).Where("userID = ?", userID).Update("fcmToken", token).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Migrator().DropTable(&device0005{})
+	},
+}
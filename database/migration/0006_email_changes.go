@@ -0,0 +1,30 @@
+package migration
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type emailChange0006 struct {
+	ChangeID  string    `gorm:"column:changeID;primaryKey;"`
+	UserID    string    `gorm:"column:userID;index"`
+	Email     string    `gorm:"column:email"`
+	TokenHash string    `gorm:"column:tokenHash;size:64;uniqueIndex"`
+	ExpiresAt time.Time `gorm:"column:expiresAt"`
+	CreatedAt time.Time `gorm:"column:createdAt;autoCreateTime"`
+}
+
+func (emailChange0006) TableName() string { return "email_changes" }
+
+// 확인을 기다리는 이메일 변경 요청 테이블을 추가합니다.
+var m0006EmailChanges = Migration{
+	Version: 6,
+	Name:    "email_changes",
+	Up: func(tx *gorm.DB) error {
+		return tx.AutoMigrate(&emailChange0006{})
+	},
+	Down: func(tx *gorm.DB) error {
+		return tx.Migrator().DropTable(&emailChange0006{})
+	},
+}
@@ -0,0 +1,38 @@
+package migration
+
+import "gorm.io/gorm"
+
+type chatRoomSettings0007 struct {
+	ChatRoomID      string `gorm:"column:chatRoomID;primaryKey;"`
+	Title           string `gorm:"column:title;size:100"`
+	Description     string `gorm:"column:description;size:500"`
+	DefaultLanguage string `gorm:"column:defaultLanguage;size:16"`
+	Avatar          string `gorm:"column:avatar"`
+}
+
+func (chatRoomSettings0007) TableName() string { return "chat_rooms" }
+
+// 소유자와 관리자가 수정할 수 있는 채팅방 설정(제목, 설명, 기본 언어, 대표 이미지)을 추가합니다.
+var m0007ChatRoomSettings = Migration{
+	Version: 7,
+	Name:    "chat_room_settings",
+	Up: func(tx *gorm.DB) error {
+		for _, field := range []string{"Title", "Description", "DefaultLanguage", "Avatar"} {
+			if tx.Migrator().HasColumn(&chatRoomSettings0007{}, field) {
+				continue
+			}
+			if err := tx.Migrator().AddColumn(&chatRoomSettings0007{}, field); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+	Down: func(tx *gorm.DB) error {
+		for _, field := range []string{"Title", "Description", "DefaultLanguage", "Avatar"} {
+			if err := tx.Migrator().DropColumn(&chatRoomSettings0007{}, field); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
@@ -0,0 +1,31 @@
+package migration
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type companyInvitation0008 struct {
+	InvitationID string    `gorm:"column:invitationID;primaryKey;"`
+	CompanyID    string    `gorm:"column:companyID;uniqueIndex:idx_company_invitation"`
+	Email        string    `gorm:"column:email;uniqueIndex:idx_company_invitation;index"`
+	Role         string    `gorm:"column:role"`
+	InvitedBy    string    `gorm:"column:invitedBy"`
+	ExpiresAt    time.Time `gorm:"column:expiresAt"`
+	CreatedAt    time.Time `gorm:"column:createdAt;autoCreateTime"`
+}
+
+func (companyInvitation0008) TableName() string { return "company_invitations" }
+
+// 사용자가 수락해야 회사에 소속되는 회사 초대 테이블을 추가합니다.
+var m0008CompanyInvitations = Migration{
+	Version: 8,
+	Name:    "company_invitations",
+	Up: func(tx *gorm.DB) error {
+		return tx.AutoMigrate(&companyInvitation0008{})
+	},
+	Down: func(tx *gorm.DB) error {
+		return tx.Migrator().DropTable(&companyInvitation0008{})
+	},
+}
@@ -0,0 +1,17 @@
+package migration
+
+// All은 서버가 사용하는 전체 마이그레이션 목록을 반환합니다.
+// 새로운 마이그레이션은 NNNN_name.go 파일을 추가하고 이 목록의 마지막에 등록합니다.
+// 이미 배포된 마이그레이션은 수정하지 않습니다.
+func All() []Migration {
+	return []Migration{
+		m0001InitialSchema,
+		m0002BackfillChatRoomOwners,
+		m0003Glossary,
+		m0004ChatRoomMemberMuted,
+		m0005Devices,
+		m0006EmailChanges,
+		m0007ChatRoomSettings,
+		m0008CompanyInvitations,
+	}
+}
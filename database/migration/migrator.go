@@ -0,0 +1,271 @@
+// migration 패키지는 버전이 매겨진 스키마 마이그레이션을 순서대로 적용하고 되돌립니다.
+//
+// 적용 내역은 schema_migrations 테이블에 기록되며, MariaDB(MySQL) 에서는
+// GET_LOCK 으로 여러 서버 인스턴스가 동시에 마이그레이션하지 못하도록 합니다.
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	lockName = "bridger_schema_migrations"
+	// 다른 인스턴스가 마이그레이션 중일 때 기다리는 최대 시간
+	defaultLockTimeout = 60 * time.Second
+)
+
+var ErrLockTimeout = errors.New("다른 인스턴스가 마이그레이션을 진행 중입니다")
+
+// Migration은 하나의 스키마 변경 단계입니다.
+// Up, Down 은 트랜잭션 안에서 실행되지만, MariaDB 의 DDL 은 암묵적으로 commit 되므로
+// 하나의 Migration 에는 가능한 한 하나의 DDL 만 두는 것이 좋습니다.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      func(tx *gorm.DB) error
+	Down    func(tx *gorm.DB) error
+}
+
+// Status는 마이그레이션 하나의 적용 상태입니다.
+type Status struct {
+	Version   int64
+	Name      string
+	Applied   bool
+	AppliedAt *time.Time
+}
+
+// schemaMigration은 적용된 마이그레이션 기록입니다.
+type schemaMigration struct {
+	Version   int64     `gorm:"column:version;primaryKey;autoIncrement:false"`
+	Name      string    `gorm:"column:name"`
+	AppliedAt time.Time `gorm:"column:appliedAt"`
+}
+
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// Migrator는 마이그레이션 목록을 데이터베이스에 적용합니다.
+type Migrator struct {
+	db          *gorm.DB
+	migrations  []Migration
+	LockTimeout time.Duration
+}
+
+// New는 Migrator 를 생성합니다. 마이그레이션은 Version 순으로 정렬되며,
+// 중복된 Version 이 있으면 error 를 반환합니다.
+func New(db *gorm.DB, migrations []Migration) (*Migrator, error) {
+	sorted := make([]Migration, len(migrations))
+	copy(sorted, migrations)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Version < sorted[j].Version
+	})
+
+	for i := range sorted {
+		if sorted[i].Up == nil {
+			return nil, fmt.Errorf("마이그레이션 %d(%s)에 Up 이 없습니다", sorted[i].Version, sorted[i].Name)
+		}
+		if i > 0 && sorted[i].Version == sorted[i-1].Version {
+			return nil, fmt.Errorf("마이그레이션 버전 %d 이 중복되었습니다", sorted[i].Version)
+		}
+	}
+
+	return &Migrator{db: db, migrations: sorted, LockTimeout: defaultLockTimeout}, nil
+}
+
+// Up은 적용되지 않은 마이그레이션을 모두 순서대로 적용합니다.
+//
+// 반환 값
+//   - []Migration: 이번에 적용된 마이그레이션 목록
+//   - error: 실패 시 error 메세지 (실패한 마이그레이션 이전 단계는 적용된 상태로 남습니다)
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var applied []Migration
+
+	err := m.withLock(ctx, func() error {
+		done, err := m.appliedVersions(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			if _, ok := done[migration.Version]; ok {
+				continue
+			}
+			if err := m.apply(ctx, migration); err != nil {
+				return err
+			}
+			applied = append(applied, migration)
+		}
+		return nil
+	})
+
+	return applied, err
+}
+
+// Down은 가장 최근에 적용된 마이그레이션부터 steps 개를 되돌립니다.
+//
+// 반환 값
+//   - []Migration: 이번에 되돌린 마이그레이션 목록
+//   - error: 실패 시 error 메세지
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	var reverted []Migration
+
+	err := m.withLock(ctx, func() error {
+		done, err := m.appliedVersions(ctx)
+		if err != nil {
+			return err
+		}
+
+		for i := len(m.migrations) - 1; i >= 0 && len(reverted) < steps; i-- {
+			migration := m.migrations[i]
+			if _, ok := done[migration.Version]; !ok {
+				continue
+			}
+			if err := m.revert(ctx, migration); err != nil {
+				return err
+			}
+			reverted = append(reverted, migration)
+		}
+		return nil
+	})
+
+	return reverted, err
+}
+
+// Status는 모든 마이그레이션의 적용 상태를 Version 순으로 반환합니다.
+// 스키마를 변경하지 않으며, schema_migrations 테이블이 없으면 모두 적용되지 않은 것으로 반환합니다.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	// HasTable 은 조회 error 를 반환하지 않으므로, 취소된 요청이 "테이블 없음"으로 보이지 않도록 먼저 확인합니다.
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	db := m.db.WithContext(ctx)
+
+	var records []schemaMigration
+	if db.Migrator().HasTable(&schemaMigration{}) {
+		if err := db.Find(&records).Error; err != nil {
+			return nil, err
+		}
+	}
+	appliedAt := make(map[int64]time.Time, len(records))
+	for _, r := range records {
+		appliedAt[r.Version] = r.AppliedAt
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := Status{Version: migration.Version, Name: migration.Name}
+		if at, ok := appliedAt[migration.Version]; ok {
+			status.Applied = true
+			status.AppliedAt = &at
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// Pending은 적용되지 않은 마이그레이션 수를 반환합니다.
+func (m *Migrator) Pending(ctx context.Context) (int, error) {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	pending := 0
+	for _, s := range statuses {
+		if !s.Applied {
+			pending++
+		}
+	}
+	return pending, nil
+}
+
+func (m *Migrator) apply(ctx context.Context, migration Migration) error {
+	err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := migration.Up(tx); err != nil {
+			return err
+		}
+		return tx.Create(&schemaMigration{Version: migration.Version, Name: migration.Name, AppliedAt: time.Now()}).Error
+	})
+	if err != nil {
+		return fmt.Errorf("마이그레이션 %d(%s) 적용 실패: %w", migration.Version, migration.Name, err)
+	}
+	return nil
+}
+
+func (m *Migrator) revert(ctx context.Context, migration Migration) error {
+	if migration.Down == nil {
+		return fmt.Errorf("마이그레이션 %d(%s)는 되돌릴 수 없습니다", migration.Version, migration.Name)
+	}
+
+	err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := migration.Down(tx); err != nil {
+			return err
+		}
+		return tx.Delete(&schemaMigration{}, "version = ?", migration.Version).Error
+	})
+	if err != nil {
+		return fmt.Errorf("마이그레이션 %d(%s) 되돌리기 실패: %w", migration.Version, migration.Name, err)
+	}
+	return nil
+}
+
+// ensureTable은 schema_migrations 테이블이 없으면 생성합니다. 잠금을 잡은 Up, Down 에서만 호출합니다.
+func (m *Migrator) ensureTable(ctx context.Context) error {
+	return m.db.WithContext(ctx).AutoMigrate(&schemaMigration{})
+}
+
+func (m *Migrator) appliedVersions(ctx context.Context) (map[int64]struct{}, error) {
+	if err := m.ensureTable(ctx); err != nil {
+		return nil, err
+	}
+
+	var versions []int64
+	if err := m.db.WithContext(ctx).Model(&schemaMigration{}).Pluck("version", &versions).Error; err != nil {
+		return nil, err
+	}
+
+	done := make(map[int64]struct{}, len(versions))
+	for _, v := range versions {
+		done[v] = struct{}{}
+	}
+	return done, nil
+}
+
+// withLock은 데이터베이스 수준의 잠금을 잡은 상태에서 fn 을 실행합니다.
+// MariaDB(MySQL) 의 GET_LOCK 은 세션 단위이므로 전용 연결을 잡아둔 채로 진행합니다.
+func (m *Migrator) withLock(ctx context.Context, fn func() error) error {
+	if m.db.Dialector.Name() != "mysql" {
+		return fn()
+	}
+
+	sqlDB, err := m.db.DB()
+	if err != nil {
+		return err
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	var acquired sql.NullInt64
+	timeout := int(m.LockTimeout / time.Second)
+	if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, ?)", lockName, timeout).Scan(&acquired); err != nil {
+		return err
+	}
+	if !acquired.Valid || acquired.Int64 != 1 {
+		return ErrLockTimeout
+	}
+	defer conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", lockName)
+
+	return fn()
+}
@@ -0,0 +1,33 @@
+package database
+
+import (
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+)
+
+// openSQLite는 SQLite 데이터베이스를 엽니다.
+// 순수 Go 로 구현된 드라이버(glebarez/sqlite, modernc.org/sqlite)를 사용하므로 cgo 없이 빌드할 수 있습니다.
+//
+// 테이블, 열 이름은 MariaDB 와 같은 모델 태그(userID, chatRoomID, ownerUserID 등)를 그대로 사용합니다.
+// SQLite 는 동시에 하나의 쓰기만 허용하므로 연결을 하나만 사용하며,
+// in-memory 데이터베이스는 연결이 닫히면 사라지므로 연결을 계속 유지합니다.
+//
+// 매개 변수
+//   - path: 데이터베이스 파일 경로 (":memory:" 이면 in-memory)
+func openSQLite(path string) (*gorm.DB, error) {
+	db, err := gorm.Open(sqlite.Open(path+"?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)"), &gorm.Config{TranslateError: true})
+	if err != nil {
+		return nil, err
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	sqlDB.SetMaxOpenConns(1)
+	sqlDB.SetMaxIdleConns(1)
+	sqlDB.SetConnMaxLifetime(0)
+	sqlDB.SetConnMaxIdleTime(0)
+
+	return db, nil
+}
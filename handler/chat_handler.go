@@ -1,9 +1,10 @@
 package handler
 
 import (
-	"fmt"
 	"net/http"
 
+	"github.com/B-Bridger/server/apperror"
+	"github.com/B-Bridger/server/i18n"
 	"github.com/B-Bridger/server/model"
 	"github.com/B-Bridger/server/service"
 	"github.com/gin-gonic/gin"
@@ -15,47 +16,48 @@ type ChatRoomHandler struct {
 
 // GetChatRoom godoc
 // @Summary 채팅방 정보 조회
-// @Description 채팅방 고유 ID를 통해 채팅방 정보를 조회합니다.
+// @Description 채팅방 고유 ID를 통해 채팅방 정보를 멤버 목록과 함께 조회합니다. 채팅방 멤버가 아닐 경우, 403 코드를 반환합니다.
 // @Tags 채팅방
+// @Security BearerAuth
 // @Param id path string true "조회할 채팅방 고유 ID"
 // @Success 200 {object} model.ChatRoomResponse
+// @Failure 403 {object} model.ErrorResponse
 // @Failure 404 {object} model.ErrorResponse
 // @Router /chat-room/{id} [get]
 func (h *ChatRoomHandler) GetChatRoom(c *gin.Context) {
-	id := c.Param("id")
-	chatRoom, err := h.Service.GetChatRoomByID(id)
+	userID := c.MustGet("userID").(string)
+	chatRoom, err := h.Service.GetChatRoomForMember(c.Request.Context(), c.Param("id"), userID)
 	if err != nil {
-		c.JSON(http.StatusNotFound, model.ErrorResponse{Message: "채팅방을 찾을 수 없습니다.", Detail: err.Error(), Status: 404})
+		c.Error(err)
 		return
 	}
-	c.JSON(http.StatusOK, model.ChatRoomResponse{Message: "채팅방을 성공적으로 조회하였습니다", Status: 200, ChatRoom: *chatRoom})
+	c.JSON(http.StatusOK, model.ChatRoomResponse{Message: i18n.T(c, i18n.MsgChatRoomFetched), Status: 200, ChatRoom: *chatRoom})
 }
 
-// GetChatRoomByOwner godoc
-// @Summary 채팅방 정보 조회
-// @Description 소유자 고유 ID를 통해 채팅방 정보를 조회합니다.
+// GetChatRooms godoc
+// @Summary 참여 중인 채팅방 목록 조회
+// @Description JWT 토큰 기반으로 사용자가 멤버로 참여 중인 채팅방 목록을 조회합니다.
 // @Tags 채팅방
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Success 200 {object} model.ChatRoomsResponse
-// @Failure 400 {object} model.ErrorResponse
-// @Failure 403 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
 // @Router /chat-rooms [get]
-func (h *ChatRoomHandler) GetChatRoomByOwner(c *gin.Context) {
+func (h *ChatRoomHandler) GetChatRooms(c *gin.Context) {
 	id := c.MustGet("userID").(string)
-	chatRooms, err := h.Service.GetChatRoomByUserID(id)
+	chatRooms, err := h.Service.GetChatRoomsByMember(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusForbidden, model.ErrorResponse{Message: "접근 권한이 없습니다.", Detail: err.Error(), Status: 403})
+		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, model.ChatRoomsResponse{Message: "채팅방을 성공적으로 조회하였습니다", Status: 200, ChatRooms: *chatRooms})
+	c.JSON(http.StatusOK, model.ChatRoomsResponse{Message: i18n.T(c, i18n.MsgChatRoomsFetched), Status: 200, ChatRooms: *chatRooms})
 }
 
 // CreateChatRoom godoc
 // @Summary 채팅방 생성
-// @Description 새로운 채팅방을 생성합니다.
+// @Description 새로운 채팅방을 생성합니다. 생성한 사용자는 owner, inviteUserIDs 의 사용자는 member 로 참여합니다.
 // @Tags 채팅방
 // @Accept json
 // @Produce json
@@ -63,71 +65,158 @@ func (h *ChatRoomHandler) GetChatRoomByOwner(c *gin.Context) {
 // @Param user body model.CreateChatRoomModel true "채팅방 정보"
 // @Success 201 {object} model.ChatRoomResponse
 // @Failure 400 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
 // @Failure 500 {object} model.ErrorResponse
 // @Router /chat-room [post]
 func (h *ChatRoomHandler) CreateChatRoom(c *gin.Context) {
 	id := c.MustGet("userID").(string)
 	var req model.CreateChatRoomModel
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, model.ErrorResponse{Message: "요청 형식이 잘못되었습니다", Detail: err.Error(), Status: 400})
+		c.Error(apperror.ErrInvalidRequest.WithDetail("%v", err))
 		return
 	}
 
-	chatRoom := model.ChatRoom{
-		UserID: id,
+	chatRoom, err := h.Service.CreateChatRoom(c.Request.Context(), id, req.InviteUserIDS)
+	if err != nil {
+		c.Error(err)
+		return
 	}
 
-	if err := h.Service.CreateChatRoom(&chatRoom); err != nil {
-		c.JSON(http.StatusInternalServerError, model.ErrorResponse{Message: "채팅방 생성에 실패하였습니다", Detail: err.Error(), Status: 500})
+	c.JSON(http.StatusCreated, model.ChatRoomResponse{Message: i18n.T(c, i18n.MsgChatRoomCreated), Status: 201, ChatRoom: *chatRoom})
+}
+
+// AddMembers godoc
+// @Summary 채팅방 멤버 추가
+// @Description 채팅방에 사용자를 초대합니다. owner, admin 만 초대할 수 있으며 admin 역할은 owner 만 부여할 수 있습니다.
+// @Tags 채팅방
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "채팅방 고유 ID"
+// @Param members body model.ChatRoomMembersModel true "초대할 사용자 ID 목록과 역할"
+// @Success 201 {object} model.ChatRoomMembersResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /chat-room/{id}/members [post]
+func (h *ChatRoomHandler) AddMembers(c *gin.Context) {
+	userID := c.MustGet("userID").(string)
+	chatRoomID := c.Param("id")
+	var req model.ChatRoomMembersModel
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apperror.ErrInvalidRequest.WithDetail("%v", err))
 		return
 	}
 
-	readChatRoom, err := h.Service.GetChatRoomByID(chatRoom.ChatRoomID)
+	members, err := h.Service.AddMembers(c.Request.Context(), chatRoomID, userID, &req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, model.ErrorResponse{Message: "요청을 처리하는 중 오류가 발생하였습니다", Detail: err.Error(), Status: 500})
+		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusCreated, model.ChatRoomResponse{Message: "채팅방을 성공적으로 생성하였습니다", Status: 201, ChatRoom: *readChatRoom})
+	c.JSON(http.StatusCreated, model.ChatRoomMembersResponse{Message: i18n.T(c, i18n.MsgMembersAdded), Status: 201, Members: members})
 }
 
-// UpdateChatRoom Docs
-// @Summary 채팅방 정보 업데이트
-// @Description 채팅방 정보를 수정합니다. JWT Token을 기반으로 Owner가 아닐 경우, 403 코드를 반환합니다.
+// RemoveMembers godoc
+// @Summary 채팅방 멤버 제거
+// @Description 채팅방에서 멤버를 내보냅니다. 자기 자신은 누구나 내보낼 수 있고(퇴장), 다른 멤버는 owner, admin 만 내보낼 수 있습니다.
 // @Tags 채팅방
 // @Accept json
 // @Produce json
 // @Security BearerAuth
-// @Param id path string true "수정할 채팅방 고유 ID"
-// @Param chatRoom body model.ChatRoom true "수정할 채팅방 정보"
-// @Success 200 {object} model.ChatRoomResponse
+// @Param id path string true "채팅방 고유 ID"
+// @Param members body model.ChatRoomMembersModel true "내보낼 사용자 ID 목록"
+// @Success 200 {object} model.OKResponse
 // @Failure 400 {object} model.ErrorResponse
 // @Failure 403 {object} model.ErrorResponse
 // @Failure 500 {object} model.ErrorResponse
-// @Router /chat-room/{id} [PUT]
-func (h *ChatRoomHandler) UpdateChatRoom(c *gin.Context) {
-	// FIXME: 업데이트 로직 변경
+// @Router /chat-room/{id}/members [delete]
+func (h *ChatRoomHandler) RemoveMembers(c *gin.Context) {
 	userID := c.MustGet("userID").(string)
 	chatRoomID := c.Param("id")
-	fmt.Println(chatRoomID)
-	var req model.ChatRoom
+	var req model.ChatRoomMembersModel
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apperror.ErrInvalidRequest.WithDetail("%v", err))
+		return
+	}
+
+	if err := h.Service.RemoveMembers(c.Request.Context(), chatRoomID, userID, req.UserIDs); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.OKResponse{Message: i18n.T(c, i18n.MsgMembersRemoved), Status: 200})
+}
+
+// MuteChatRoom godoc
+// @Summary 채팅방 알림 설정
+// @Description 요청한 사용자의 채팅방 새 메세지 푸시 알림을 끄거나 켭니다.
+// @Tags 채팅방
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "채팅방 고유 ID"
+// @Param mute body model.ChatRoomMuteModel true "알림 끄기 여부"
+// @Success 200 {object} model.OKResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /chat-room/{id}/mute [put]
+func (h *ChatRoomHandler) MuteChatRoom(c *gin.Context) {
+	userID := c.MustGet("userID").(string)
+	var req model.ChatRoomMuteModel
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, model.ErrorResponse{Message: "요청 형식이 잘못되었습니다", Detail: err.Error(), Status: 400})
+		c.Error(apperror.ErrInvalidRequest.WithDetail("%v", err))
 		return
 	}
 
-	if userID != req.UserID {
-		c.JSON(http.StatusForbidden, model.ErrorResponse{Message: "접근 권한이 없습니다", Detail: "JWT token value doesn't match Owner", Status: 403})
+	if err := h.Service.SetMuted(c.Request.Context(), c.Param("id"), userID, req.Muted); err != nil {
+		c.Error(err)
+		return
+	}
+
+	message := i18n.MsgChatRoomUnmuted
+	if req.Muted {
+		message = i18n.MsgChatRoomMuted
+	}
+	c.JSON(http.StatusOK, model.OKResponse{Message: i18n.T(c, message), Status: 200})
+}
+
+// UpdateChatRoom godoc
+// @Summary 채팅방 설정 수정
+// @Description JSON merge patch 로 채팅방의 제목, 설명, 기본 언어, 대표 이미지를 수정합니다. 요청에 없는 필드는 변경하지 않으며, null 은 값을 지웁니다.
+// @Description 채팅방 소유자와 관리자만 수정할 수 있으며, 소유자, 멤버, 마지막 메세지, 생성 시각 등 서버가 관리하는 필드가 포함되면 read_only_field 오류를 반환합니다.
+// @Description PUT 은 이전 클라이언트 호환을 위해 남겨둔 경로이며 PATCH 와 같습니다.
+// @Tags 채팅방
+// @Accept application/merge-patch+json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "수정할 채팅방 고유 ID"
+// @Param chatRoom body model.UpdateChatRoomModel true "수정할 채팅방 설정"
+// @Success 200 {object} model.ChatRoomResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 415 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /chat-room/{id} [patch]
+// @Router /chat-room/{id} [put]
+func (h *ChatRoomHandler) UpdateChatRoom(c *gin.Context) {
+	userID := c.MustGet("userID").(string)
+	var req model.UpdateChatRoomModel
+	if err := bindMergePatch(c, &req); err != nil {
+		c.Error(err)
 		return
 	}
 
-	updatedChatRoom, err := h.Service.UpdateChatRoom(&req)
+	updatedChatRoom, err := h.Service.UpdateChatRoom(c.Request.Context(), c.Param("id"), userID, &req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, model.ErrorResponse{Message: "정보 수정중 오류가 발생하였습니다", Detail: err.Error(), Status: 500})
+		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, model.ChatRoomResponse{Message: "정보를 성공적으로 수정하였습니다", Status: 200, ChatRoom: *updatedChatRoom})
+	c.JSON(http.StatusOK, model.ChatRoomResponse{Message: i18n.T(c, i18n.MsgChatRoomUpdated), Status: 200, ChatRoom: *updatedChatRoom})
 }
 
 // DeleteChatRoom godoc
@@ -144,22 +233,22 @@ func (h *ChatRoomHandler) UpdateChatRoom(c *gin.Context) {
 func (h *ChatRoomHandler) DeleteChatRoom(c *gin.Context) {
 	id := c.MustGet("userID").(string)
 	chatRoomID := c.Param("id")
-	chatRoom, err := h.Service.GetChatRoomByID(chatRoomID)
+	chatRoom, err := h.Service.GetChatRoomByID(c.Request.Context(), chatRoomID)
 	if err != nil {
-		c.JSON(http.StatusNotFound, model.ErrorResponse{Message: "채팅방을 찾을 수 없습니다", Detail: err.Error(), Status: 404})
+		c.Error(err)
 		return
 	}
 
 	if chatRoom.UserID != id {
-		c.JSON(http.StatusForbidden, model.ErrorResponse{Message: "접근 권한이 없습니다", Detail: "You are not chat room's owner", Status: 403})
+		c.Error(service.ErrNotChatRoomOwner)
 		return
 	}
 
-	err = h.Service.DeleteChatRoom(chatRoomID)
+	err = h.Service.DeleteChatRoom(c.Request.Context(), chatRoomID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, model.ErrorResponse{Message: "제거에 실패하였습니다", Detail: err.Error(), Status: 500})
+		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, model.OKResponse{Message: "성공적으로 채팅방을 제거하였습니다", Status: 200})
+	c.JSON(http.StatusOK, model.OKResponse{Message: i18n.T(c, i18n.MsgChatRoomDeleted), Status: 200})
 }
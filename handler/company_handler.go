@@ -0,0 +1,268 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/B-Bridger/server/apperror"
+	"github.com/B-Bridger/server/i18n"
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/service"
+	"github.com/gin-gonic/gin"
+)
+
+type CompanyHandler struct {
+	Service *service.CompanyService
+}
+
+// CreateCompany godoc
+// @Summary 회사 생성
+// @Description 새로운 회사를 생성하고 요청한 사용자를 회사 관리자로 지정합니다. 변경된 소속 정보는 토큰 재발급 후 토큰에 반영됩니다.
+// @Tags 회사
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param company body model.CreateCompanyModel true "회사 정보"
+// @Success 201 {object} model.CompanyResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 409 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /companies [post]
+func (h *CompanyHandler) CreateCompany(c *gin.Context) {
+	userID := c.MustGet("userID").(string)
+	var req model.CreateCompanyModel
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apperror.ErrInvalidRequest.WithDetail("%v", err))
+		return
+	}
+
+	company, err := h.Service.CreateCompany(c.Request.Context(), userID, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, model.CompanyResponse{Message: i18n.T(c, i18n.MsgCompanyCreated), Status: 201, Company: *company})
+}
+
+// GetCompany godoc
+// @Summary 회사 정보 조회
+// @Description 소속된 회사의 정보를 조회합니다.
+// @Tags 회사
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "회사 고유 ID"
+// @Success 200 {object} model.CompanyResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Router /companies/{id} [get]
+func (h *CompanyHandler) GetCompany(c *gin.Context) {
+	userID := c.MustGet("userID").(string)
+	company, err := h.Service.GetCompany(c.Request.Context(), c.Param("id"), userID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.CompanyResponse{Message: i18n.T(c, i18n.MsgCompanyFetched), Status: 200, Company: *company})
+}
+
+// UpdateCompany godoc
+// @Summary 회사 정보 수정
+// @Description 회사 정보를 수정합니다. 회사 관리자가 아닐 경우, 403 코드를 반환합니다.
+// @Tags 회사
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "회사 고유 ID"
+// @Param company body model.CreateCompanyModel true "수정할 회사 정보"
+// @Success 200 {object} model.CompanyResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /companies/{id} [put]
+func (h *CompanyHandler) UpdateCompany(c *gin.Context) {
+	userID := c.MustGet("userID").(string)
+	var req model.CreateCompanyModel
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apperror.ErrInvalidRequest.WithDetail("%v", err))
+		return
+	}
+
+	company, err := h.Service.UpdateCompany(c.Request.Context(), c.Param("id"), userID, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.CompanyResponse{Message: i18n.T(c, i18n.MsgCompanyUpdated), Status: 200, Company: *company})
+}
+
+// GetCompanyUsers godoc
+// @Summary 회사 사용자 목록 조회
+// @Description 같은 회사에 소속된 사용자 목록을 조회합니다. q 로 이름, 이메일을 검색할 수 있습니다.
+// @Tags 회사
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "회사 고유 ID"
+// @Param q query string false "이름, 이메일 검색어"
+// @Success 200 {object} model.UsersResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /companies/{id}/users [get]
+func (h *CompanyHandler) GetCompanyUsers(c *gin.Context) {
+	userID := c.MustGet("userID").(string)
+	users, err := h.Service.GetDirectory(c.Request.Context(), c.Param("id"), userID, c.Query("q"))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.UsersResponse{Message: i18n.T(c, i18n.MsgCompanyUsersFetched), Status: 200, Users: users})
+}
+
+// InviteCompanyMember godoc
+// @Summary 회사 사용자 초대
+// @Description 이메일로 회사 초대를 보냅니다. 초대받은 사용자가 수락해야 회사에 소속되며, 초대는 7일 동안 유효합니다. 회사 관리자가 아닐 경우, 403 코드를 반환합니다.
+// @Tags 회사
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "회사 고유 ID"
+// @Param member body model.CompanyMemberModel true "초대할 사용자의 이메일과 역할"
+// @Success 201 {object} model.CompanyInvitationResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 409 {object} model.ErrorResponse
+// @Router /companies/{id}/invitations [post]
+func (h *CompanyHandler) InviteCompanyMember(c *gin.Context) {
+	userID := c.MustGet("userID").(string)
+	var req model.CompanyMemberModel
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apperror.ErrInvalidRequest.WithDetail("%v", err))
+		return
+	}
+
+	invitation, err := h.Service.InviteMember(c.Request.Context(), c.Param("id"), userID, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, model.CompanyInvitationResponse{Message: i18n.T(c, i18n.MsgCompanyInvitationSent), Status: 201, Invitation: *invitation})
+}
+
+// GetCompanyInvitations godoc
+// @Summary 받은 회사 초대 목록 조회
+// @Description 요청한 사용자의 이메일로 받은, 만료되지 않은 회사 초대 목록을 조회합니다.
+// @Tags 회사
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} model.CompanyInvitationsResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Router /companies/invitations [get]
+func (h *CompanyHandler) GetCompanyInvitations(c *gin.Context) {
+	userID := c.MustGet("userID").(string)
+	invitations, err := h.Service.GetInvitations(c.Request.Context(), userID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.CompanyInvitationsResponse{Message: i18n.T(c, i18n.MsgCompanyInvitationsFetched), Status: 200, Invitations: invitations})
+}
+
+// AcceptCompanyInvitation godoc
+// @Summary 회사 초대 수락
+// @Description 받은 회사 초대를 수락하여 회사에 소속됩니다. 변경된 소속 정보는 토큰 재발급 후 토큰에 반영됩니다.
+// @Tags 회사
+// @Produce json
+// @Security BearerAuth
+// @Param invitationID path string true "초대 고유 ID"
+// @Success 200 {object} model.UserResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 409 {object} model.ErrorResponse
+// @Router /companies/invitations/{invitationID}/accept [post]
+func (h *CompanyHandler) AcceptCompanyInvitation(c *gin.Context) {
+	userID := c.MustGet("userID").(string)
+	user, err := h.Service.AcceptInvitation(c.Request.Context(), c.Param("invitationID"), userID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.UserResponse{Message: i18n.T(c, i18n.MsgCompanyInvitationAccepted), Status: 200, User: *user})
+}
+
+// DeleteCompanyInvitation godoc
+// @Summary 회사 초대 거절, 취소
+// @Description 초대받은 사용자는 초대를 거절하고, 초대한 회사의 관리자는 초대를 취소합니다.
+// @Tags 회사
+// @Security BearerAuth
+// @Param invitationID path string true "초대 고유 ID"
+// @Success 200 {object} model.OKResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Router /companies/invitations/{invitationID} [delete]
+func (h *CompanyHandler) DeleteCompanyInvitation(c *gin.Context) {
+	userID := c.MustGet("userID").(string)
+	if err := h.Service.DeleteInvitation(c.Request.Context(), c.Param("invitationID"), userID); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.OKResponse{Message: i18n.T(c, i18n.MsgCompanyInvitationDeleted), Status: 200})
+}
+
+// UpdateCompanyMemberRole godoc
+// @Summary 회사 사용자 역할 변경
+// @Description 회사 사용자의 역할을 변경합니다. 회사 관리자만 변경할 수 있으며, 마지막 관리자의 역할은 바꿀 수 없습니다.
+// @Tags 회사
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "회사 고유 ID"
+// @Param userID path string true "역할을 변경할 사용자 고유 ID"
+// @Param role body model.CompanyRoleModel true "변경할 역할"
+// @Success 200 {object} model.UserResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 409 {object} model.ErrorResponse
+// @Router /companies/{id}/members/{userID} [put]
+func (h *CompanyHandler) UpdateCompanyMemberRole(c *gin.Context) {
+	userID := c.MustGet("userID").(string)
+	var req model.CompanyRoleModel
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apperror.ErrInvalidRequest.WithDetail("%v", err))
+		return
+	}
+
+	user, err := h.Service.UpdateMemberRole(c.Request.Context(), c.Param("id"), userID, c.Param("userID"), req.Role)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.UserResponse{Message: i18n.T(c, i18n.MsgCompanyMemberRoleUpdated), Status: 200, User: *user})
+}
+
+// RemoveCompanyMember godoc
+// @Summary 회사 사용자 제거
+// @Description 사용자의 회사 소속을 해제합니다. 자기 자신은 누구나, 다른 사용자는 회사 관리자만 해제할 수 있습니다.
+// @Tags 회사
+// @Security BearerAuth
+// @Param id path string true "회사 고유 ID"
+// @Param userID path string true "소속을 해제할 사용자 고유 ID"
+// @Success 200 {object} model.OKResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 409 {object} model.ErrorResponse
+// @Router /companies/{id}/members/{userID} [delete]
+func (h *CompanyHandler) RemoveCompanyMember(c *gin.Context) {
+	userID := c.MustGet("userID").(string)
+	if err := h.Service.RemoveMember(c.Request.Context(), c.Param("id"), userID, c.Param("userID")); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.OKResponse{Message: i18n.T(c, i18n.MsgCompanyMemberRemoved), Status: 200})
+}
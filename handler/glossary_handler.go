@@ -0,0 +1,224 @@
+package handler
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/B-Bridger/server/apperror"
+	"github.com/B-Bridger/server/i18n"
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/service"
+	"github.com/gin-gonic/gin"
+)
+
+// 용어집 CSV 업로드 최대 크기
+const maxGlossaryCSVSize = 2 << 20
+
+// GlossaryHandler는 회사, 채팅방 용어집 요청을 처리합니다.
+// 같은 핸들러를 두 범위에서 사용하므로, 각 메서드는 범위를 받아 gin.HandlerFunc 를 반환합니다.
+// 회사 또는 채팅방의 고유 ID 는 경로의 :id 로 전달됩니다.
+type GlossaryHandler struct {
+	Service *service.GlossaryService
+}
+
+// GetTerms godoc
+// @Summary 용어집 조회
+// @Description 회사 또는 채팅방 용어집의 용어 목록을 조회합니다.
+// @Tags 용어집
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "회사 또는 채팅방 고유 ID"
+// @Success 200 {object} model.GlossaryTermsResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /companies/{id}/glossary [get]
+// @Router /chat-room/{id}/glossary [get]
+func (h *GlossaryHandler) GetTerms(scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := c.MustGet("userID").(string)
+		terms, err := h.Service.GetTerms(c.Request.Context(), scope, c.Param("id"), userID)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, model.GlossaryTermsResponse{Message: i18n.T(c, i18n.MsgGlossaryFetched), Status: 200, Terms: terms})
+	}
+}
+
+// CreateTerm godoc
+// @Summary 용어 추가
+// @Description 용어집에 용어를 추가합니다. 회사 용어집은 회사 관리자, 채팅방 용어집은 owner, admin 만 수정할 수 있습니다.
+// @Tags 용어집
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "회사 또는 채팅방 고유 ID"
+// @Param term body model.GlossaryTermModel true "용어 정보"
+// @Success 201 {object} model.GlossaryTermResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 409 {object} model.ErrorResponse
+// @Router /companies/{id}/glossary [post]
+// @Router /chat-room/{id}/glossary [post]
+func (h *GlossaryHandler) CreateTerm(scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := c.MustGet("userID").(string)
+		var req model.GlossaryTermModel
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(apperror.ErrInvalidRequest.WithDetail("%v", err))
+			return
+		}
+
+		term, err := h.Service.CreateTerm(c.Request.Context(), scope, c.Param("id"), userID, &req)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusCreated, model.GlossaryTermResponse{Message: i18n.T(c, i18n.MsgGlossaryTermCreated), Status: 201, Term: *term})
+	}
+}
+
+// UpdateTerm godoc
+// @Summary 용어 수정
+// @Description 용어 정보를 수정합니다. 번역어 목록은 요청한 값으로 교체됩니다.
+// @Tags 용어집
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "회사 또는 채팅방 고유 ID"
+// @Param termID path string true "용어 고유 ID"
+// @Param term body model.GlossaryTermModel true "수정할 용어 정보"
+// @Success 200 {object} model.GlossaryTermResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 409 {object} model.ErrorResponse
+// @Router /companies/{id}/glossary/{termID} [put]
+// @Router /chat-room/{id}/glossary/{termID} [put]
+func (h *GlossaryHandler) UpdateTerm(scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := c.MustGet("userID").(string)
+		var req model.GlossaryTermModel
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(apperror.ErrInvalidRequest.WithDetail("%v", err))
+			return
+		}
+
+		term, err := h.Service.UpdateTerm(c.Request.Context(), scope, c.Param("id"), userID, c.Param("termID"), &req)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, model.GlossaryTermResponse{Message: i18n.T(c, i18n.MsgGlossaryTermUpdated), Status: 200, Term: *term})
+	}
+}
+
+// DeleteTerm godoc
+// @Summary 용어 삭제
+// @Description 용어집에서 용어를 삭제합니다.
+// @Tags 용어집
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "회사 또는 채팅방 고유 ID"
+// @Param termID path string true "용어 고유 ID"
+// @Success 200 {object} model.OKResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Router /companies/{id}/glossary/{termID} [delete]
+// @Router /chat-room/{id}/glossary/{termID} [delete]
+func (h *GlossaryHandler) DeleteTerm(scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := c.MustGet("userID").(string)
+		if err := h.Service.DeleteTerm(c.Request.Context(), scope, c.Param("id"), userID, c.Param("termID")); err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, model.OKResponse{Message: i18n.T(c, i18n.MsgGlossaryTermDeleted), Status: 200})
+	}
+}
+
+// ExportTerms godoc
+// @Summary 용어집 CSV 내보내기
+// @Description 용어집을 CSV 로 내보냅니다. 열은 source, caseSensitive, doNotTranslate, 언어 코드 순입니다.
+// @Tags 용어집
+// @Produce text/csv
+// @Security BearerAuth
+// @Param id path string true "회사 또는 채팅방 고유 ID"
+// @Success 200 {file} file
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /companies/{id}/glossary/export [get]
+// @Router /chat-room/{id}/glossary/export [get]
+func (h *GlossaryHandler) ExportTerms(scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := c.MustGet("userID").(string)
+		terms, err := h.Service.GetTerms(c.Request.Context(), scope, c.Param("id"), userID)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		var buf bytes.Buffer
+		if err := service.WriteGlossaryCSV(&buf, terms); err != nil {
+			c.Error(apperror.Internal(err))
+			return
+		}
+
+		c.Header("Content-Disposition", `attachment; filename="glossary.csv"`)
+		c.Data(http.StatusOK, "text/csv; charset=utf-8", buf.Bytes())
+	}
+}
+
+// ImportTerms godoc
+// @Summary 용어집 CSV 가져오기
+// @Description CSV 로 용어를 가져옵니다. 같은 원문의 용어는 CSV 의 값으로 교체되며, 형식이 잘못된 행이 있으면 아무것도 저장하지 않습니다.
+// @Description multipart/form-data 의 file 필드 또는 text/csv 요청 본문으로 전달합니다.
+// @Tags 용어집
+// @Accept multipart/form-data
+// @Accept text/csv
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "회사 또는 채팅방 고유 ID"
+// @Param file formData file false "용어집 CSV 파일"
+// @Success 200 {object} model.GlossaryImportResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /companies/{id}/glossary/import [post]
+// @Router /chat-room/{id}/glossary/import [post]
+func (h *GlossaryHandler) ImportTerms(scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := c.MustGet("userID").(string)
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxGlossaryCSVSize)
+
+		var body io.Reader = c.Request.Body
+		if strings.HasPrefix(c.ContentType(), "multipart/") {
+			header, err := c.FormFile("file")
+			if err != nil {
+				c.Error(service.ErrInvalidGlossaryCSV.WithDetail("CSV 파일이 없습니다: %v", err))
+				return
+			}
+			file, err := header.Open()
+			if err != nil {
+				c.Error(service.ErrInvalidGlossaryCSV.WithDetail("CSV 파일을 읽을 수 없습니다: %v", err))
+				return
+			}
+			defer file.Close()
+			body = file
+		}
+
+		imported, err := h.Service.ImportTerms(c.Request.Context(), scope, c.Param("id"), userID, body)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, model.GlossaryImportResponse{Message: i18n.T(c, i18n.MsgGlossaryImported), Status: 200, Imported: imported})
+	}
+}
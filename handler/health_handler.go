@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/B-Bridger/server/buildinfo"
+	"github.com/B-Bridger/server/i18n"
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/service"
+	"github.com/gin-gonic/gin"
+)
+
+type HealthHandler struct {
+	Service *service.HealthService
+}
+
+// Healthz godoc
+// @Summary 서버 실행 확인 (liveness)
+// @Description 프로세스가 실행 중이면 항상 200 을 반환합니다. 의존하는 서비스는 확인하지 않습니다.
+// @Tags 상태
+// @Produce json
+// @Success 200 {object} model.OKResponse
+// @Router /healthz [get]
+func (h *HealthHandler) Healthz(c *gin.Context) {
+	c.JSON(http.StatusOK, model.OKResponse{Message: i18n.T(c, i18n.MsgAlive), Status: 200})
+}
+
+// Readyz godoc
+// @Summary 요청 처리 가능 여부 확인 (readiness)
+// @Description 데이터베이스 연결, 마이그레이션 적용 상태, 번역 제공자 연결을 확인합니다. 서버가 종료 중이면 503 을 반환합니다.
+// @Tags 상태
+// @Produce json
+// @Success 200 {object} model.ReadinessResponse
+// @Failure 503 {object} model.ReadinessResponse
+// @Router /readyz [get]
+func (h *HealthHandler) Readyz(c *gin.Context) {
+	ready, checks := h.Service.Ready(c.Request.Context())
+	if !ready {
+		c.JSON(http.StatusServiceUnavailable, model.ReadinessResponse{Message: i18n.T(c, i18n.MsgNotReady), Status: 503, Ready: false, Checks: checks})
+		return
+	}
+
+	c.JSON(http.StatusOK, model.ReadinessResponse{Message: i18n.T(c, i18n.MsgReady), Status: 200, Ready: true, Checks: checks})
+}
+
+// Version godoc
+// @Summary 빌드 정보 조회
+// @Description 실행 중인 서버의 git commit, 빌드 시각, Go 버전을 조회합니다.
+// @Tags 상태
+// @Produce json
+// @Success 200 {object} model.VersionResponse
+// @Router /version [get]
+func (h *HealthHandler) Version(c *gin.Context) {
+	info := buildinfo.Get()
+	c.JSON(http.StatusOK, model.VersionResponse{Message: i18n.T(c, i18n.MsgVersionFetched), Status: 200, Commit: info.Commit, BuildTime: info.BuildTime, GoVersion: info.GoVersion})
+}
@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/B-Bridger/server/apperror"
+	"github.com/B-Bridger/server/i18n"
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/service"
+	"github.com/gin-gonic/gin"
+)
+
+type MessageHandler struct {
+	Service *service.MessageService
+}
+
+// GetMessages godoc
+// @Summary 채팅방 메세지 목록 조회
+// @Description 채팅방의 메세지를 사용자의 언어로 번역하여 최신순으로 조회합니다. 응답의 nextCursor 값을 cursor로 전달하면 이전 메세지를 이어서 조회합니다.
+// @Tags 메세지
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "조회할 채팅방 고유 ID"
+// @Param cursor query string false "이전 응답의 nextCursor"
+// @Param limit query int false "조회 개수 (기본 50, 최대 100)"
+// @Param original query bool false "true 이면 원문을 함께 반환"
+// @Success 200 {object} model.MessagesResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /chat-room/{id}/messages [get]
+func (h *MessageHandler) GetMessages(c *gin.Context) {
+	userID := c.MustGet("userID").(string)
+	chatRoomID := c.Param("id")
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "0"))
+	if err != nil {
+		c.Error(apperror.ErrInvalidRequest.WithDetail("limit: %v", err))
+		return
+	}
+	withOriginal, err := strconv.ParseBool(c.DefaultQuery("original", "false"))
+	if err != nil {
+		c.Error(apperror.ErrInvalidRequest.WithDetail("original: %v", err))
+		return
+	}
+
+	messages, nextCursor, err := h.Service.GetMessages(c.Request.Context(), chatRoomID, userID, c.Query("cursor"), limit, withOriginal)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.MessagesResponse{Message: i18n.T(c, i18n.MsgMessagesFetched), Status: 200, Messages: messages, NextCursor: nextCursor})
+}
+
+// GetMessage godoc
+// @Summary 메세지 원문 조회
+// @Description 메세지를 사용자의 언어로 번역된 내용과 원문을 함께 조회합니다.
+// @Tags 메세지
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "채팅방 고유 ID"
+// @Param messageID path string true "조회할 메세지 고유 ID"
+// @Success 200 {object} model.MessageResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Router /chat-room/{id}/messages/{messageID} [get]
+func (h *MessageHandler) GetMessage(c *gin.Context) {
+	userID := c.MustGet("userID").(string)
+	chatRoomID := c.Param("id")
+	message, err := h.Service.GetMessage(c.Request.Context(), chatRoomID, c.Param("messageID"), userID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.MessageResponse{Message: i18n.T(c, i18n.MsgMessageFetched), Status: 200, ChatMessage: *message})
+}
+
+// PostMessage godoc
+// @Summary 메세지 전송
+// @Description 채팅방에 새로운 메세지를 저장합니다. 참여자들의 언어별로 한 번씩 번역하여 함께 저장하고, 각 참여자에게 자신의 언어로 실시간 전달합니다.
+// @Tags 메세지
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "메세지를 보낼 채팅방 고유 ID"
+// @Param message body model.CreateMessageModel true "메세지 정보"
+// @Success 201 {object} model.MessageResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /chat-room/{id}/messages [post]
+func (h *MessageHandler) PostMessage(c *gin.Context) {
+	userID := c.MustGet("userID").(string)
+	chatRoomID := c.Param("id")
+	var req model.CreateMessageModel
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apperror.ErrInvalidRequest.WithDetail("%v", err))
+		return
+	}
+
+	message, err := h.Service.PostMessage(c.Request.Context(), chatRoomID, userID, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, model.MessageResponse{Message: i18n.T(c, i18n.MsgMessageSent), Status: 201, ChatMessage: *message})
+}
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/B-Bridger/server/apperror"
+	"github.com/gin-gonic/gin"
+)
+
+// JSON merge patch 문서의 media type (RFC 7396)
+const mergePatchContentType = "application/merge-patch+json"
+
+// bindMergePatch는 요청 본문을 JSON merge patch 문서로 읽어 dst 에 저장합니다.
+// Content-Type 은 application/merge-patch+json 이어야 합니다.
+// dst 의 json 태그에 없는 필드가 포함되어 있으면 ErrReadOnlyField 를 반환하여,
+// 이메일이나 비밀번호처럼 별도의 흐름으로만 변경할 수 있는 필드가 조용히 무시되지 않도록 합니다.
+//
+// 매개 변수
+//   - c: gin context
+//   - dst: 요청 DTO 구조체 포인터
+//
+// 반환 값
+//   - error: Content-Type 이 다르면 ErrContentType, 본문이 JSON 객체가 아니면 ErrInvalidRequest, 수정할 수 없는 필드가 있으면 ErrReadOnlyField
+func bindMergePatch(c *gin.Context, dst any) error {
+	if mediaType, _, err := mime.ParseMediaType(c.GetHeader("Content-Type")); err != nil || mediaType != mergePatchContentType {
+		c.Header("Accept-Patch", mergePatchContentType)
+		return apperror.ErrContentType.WithDetail("Content-Type 은 %s 이어야 합니다", mergePatchContentType)
+	}
+
+	var body bytes.Buffer
+	if _, err := body.ReadFrom(c.Request.Body); err != nil {
+		return apperror.ErrInvalidRequest.WithDetail("%v", err)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body.Bytes(), &fields); err != nil || fields == nil {
+		return apperror.ErrInvalidRequest.WithDetail("요청 본문은 JSON 객체여야 합니다")
+	}
+
+	allowed := jsonFieldNames(dst)
+	var readOnly []string
+	for name := range fields {
+		if !allowed[name] {
+			readOnly = append(readOnly, name)
+		}
+	}
+	if len(readOnly) > 0 {
+		sort.Strings(readOnly)
+		return apperror.ErrReadOnlyField.WithDetail("%s", strings.Join(readOnly, ", "))
+	}
+
+	if err := json.Unmarshal(body.Bytes(), dst); err != nil {
+		return apperror.ErrInvalidRequest.WithDetail("%v", err)
+	}
+	return nil
+}
+
+// jsonFieldNames는 구조체 포인터 v 의 json 필드 이름 집합을 반환합니다.
+func jsonFieldNames(v any) map[string]bool {
+	t := reflect.TypeOf(v).Elem()
+	names := make(map[string]bool, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name == "" {
+			name = t.Field(i).Name
+		}
+		if name != "-" {
+			names[name] = true
+		}
+	}
+	return names
+}
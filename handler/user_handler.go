@@ -4,11 +4,16 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/B-Bridger/server/apperror"
+	"github.com/B-Bridger/server/i18n"
 	"github.com/B-Bridger/server/model"
 	"github.com/B-Bridger/server/service"
 	"github.com/gin-gonic/gin"
 )
 
+// 더 이상 지원하지 않는 경로로 요청한 경우의 오류
+var ErrMethodDeprecated = apperror.New(apperror.KindMethodNotAllowed, "method_deprecated", "더 이상 지원하지 않는 요청입니다")
+
 type UserHandler struct {
 	Service *service.UserService
 }
@@ -18,6 +23,10 @@ type LoginRequest struct {
 	Password string `json:"password"`
 }
 
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
 // GetUser godoc
 // @Summary 사용자 정보 조회
 // @Description JWT 토큰에 포함된 사용자 정보를 가져옵니다.
@@ -28,12 +37,12 @@ type LoginRequest struct {
 // @Router /users [get]
 func (h *UserHandler) GetUser(c *gin.Context) {
 	id := c.MustGet("userID").(string)
-	user, err := h.Service.GetUser(id)
+	user, err := h.Service.GetUser(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusNotFound, model.ErrorResponse{Message: "사용자를 찾을 수 없습니다", Detail: err.Error(), Status: 404})
+		c.Error(err)
 		return
 	}
-	c.JSON(http.StatusOK, model.UserResponse{Message: "사용자를 성공적으로 조회하였습니다", Status: 200, User: *user})
+	c.JSON(http.StatusOK, model.UserResponse{Message: i18n.T(c, i18n.MsgUserFetched), Status: 200, User: *user})
 }
 
 // CreateUser godoc
@@ -51,7 +60,7 @@ func (h *UserHandler) GetUser(c *gin.Context) {
 func (h *UserHandler) CreateUser(c *gin.Context) {
 	var req model.CreateUserModel
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, model.ErrorResponse{Message: "요청 형식이 잘못되었습니다", Detail: err.Error(), Status: 400})
+		c.Error(apperror.ErrInvalidRequest.WithDetail("%v", err))
 		return
 	}
 
@@ -62,64 +71,170 @@ func (h *UserHandler) CreateUser(c *gin.Context) {
 		Password: req.Password,
 	}
 
-	if err := h.Service.CheckUserField(user.UserID, user.Email); err != nil {
-		c.JSON(http.StatusConflict, model.ErrorResponse{Message: "해당 사용자가 이미 존재합니다", Detail: err.Error(), Status: 409})
+	if err := h.Service.CheckUserField(c.Request.Context(), user.UserID, user.Email); err != nil {
+		c.Error(err)
 		return
 	}
 
-	if err := h.Service.CreateUser(user); err != nil {
-		c.JSON(http.StatusInternalServerError, model.ErrorResponse{Message: "사용자 생성에 실패하였습니다", Detail: err.Error(), Status: 500})
+	if err := h.Service.CreateUser(c.Request.Context(), user); err != nil {
+		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusCreated, model.UserResponse{Message: "사용자를 성공적으로 생성하였습니다", Status: 201, User: *user})
+	c.JSON(http.StatusCreated, model.UserResponse{Message: i18n.T(c, i18n.MsgUserCreated), Status: 201, User: *user})
 }
 
 // UpdateUser godoc
 // @Summary 사용자 정보 수정
-// @Description JWT 토큰 기반으로 현재 사용자 정보를 수정합니다.
+// @Description JSON merge patch 로 현재 사용자의 이름, 언어, 프로필 이미지를 수정합니다. 요청에 없는 필드는 변경하지 않으며, null 은 값을 지웁니다. (이름은 지울 수 없습니다)
+// @Description 이메일과 비밀번호는 각각 POST /users/email, PUT /users/password 로 변경하며, 그 밖의 필드가 포함되면 read_only_field 오류를 반환합니다.
 // @Tags 사용자
-// @Accept json
+// @Accept application/merge-patch+json
 // @Produce json
 // @Security BearerAuth
-// @Param user body model.User true "수정할 사용자 정보"
+// @Param user body model.UpdateUserModel true "수정할 사용자 정보"
 // @Success 200 {object} model.UserResponse
 // @Failure 400 {object} model.ErrorResponse
+// @Failure 415 {object} model.ErrorResponse
 // @Failure 500 {object} model.ErrorResponse
-// @Router /users [put]
+// @Router /users [patch]
 func (h *UserHandler) UpdateUser(c *gin.Context) {
 	id := c.MustGet("userID").(string)
-	var user model.User
-	if err := c.ShouldBindJSON(&user); err != nil {
-		c.JSON(http.StatusBadRequest, model.ErrorResponse{Message: "요청 형식이 잘못되었습니다", Detail: err.Error(), Status: 400})
+	var req model.UpdateUserModel
+	if err := bindMergePatch(c, &req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	updated, err := h.Service.UpdateUser(c.Request.Context(), id, &req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	// 토큰의 언어는 재발급 전까지 바뀌지 않으므로 이번 응답에는 변경된 언어를 바로 사용합니다.
+	c.Set(i18n.LanguageKey, updated.Language)
+
+	c.JSON(http.StatusOK, model.UserResponse{Message: i18n.T(c, i18n.MsgUserUpdated), Status: 200, User: *updated})
+}
+
+// ReplaceUser godoc
+// @Summary 사용자 정보 수정 (deprecated)
+// @Description 더 이상 지원하지 않는 경로입니다. 항상 405 method_deprecated 로 응답하며, 사용자 정보는 PATCH /users 로 수정합니다.
+// @Tags 사용자
+// @Produce json
+// @Security BearerAuth
+// @Failure 405 {object} model.ErrorResponse
+// @Deprecated
+// @Router /users [put]
+func (h *UserHandler) ReplaceUser(c *gin.Context) {
+	c.Header("Allow", "GET, PATCH, DELETE")
+	c.Error(ErrMethodDeprecated.WithDetail("PATCH /users 에 %s 로 요청해야 합니다", mergePatchContentType))
+}
+
+// RequestEmailChange godoc
+// @Summary 이메일 변경 요청
+// @Description 현재 비밀번호를 확인한 뒤 새 이메일로 확인 토큰을 발송합니다. 이메일은 POST /users/email/verify 로 토큰을 확인한 뒤에 변경됩니다.
+// @Description 토큰은 24시간 동안 유효하며, 다시 요청하면 이전 토큰은 사용할 수 없습니다.
+// @Tags 사용자
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param email body model.ChangeEmailModel true "새 이메일과 현재 비밀번호"
+// @Success 202 {object} model.OKResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 409 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /users/email [post]
+func (h *UserHandler) RequestEmailChange(c *gin.Context) {
+	id := c.MustGet("userID").(string)
+	var req model.ChangeEmailModel
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apperror.ErrInvalidRequest.WithDetail("%v", err))
+		return
+	}
+
+	if err := h.Service.RequestEmailChange(c.Request.Context(), id, &req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, model.OKResponse{Message: i18n.T(c, i18n.MsgEmailVerificationSent), Status: 202})
+}
+
+// VerifyEmail godoc
+// @Summary 이메일 변경 확인
+// @Description 새 이메일로 발송된 확인 토큰으로 이메일 변경을 완료합니다. 토큰은 한 번만 사용할 수 있습니다.
+// @Tags 사용자
+// @Accept json
+// @Produce json
+// @Param token body model.VerifyEmailModel true "확인 토큰"
+// @Success 200 {object} model.UserResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 409 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /users/email/verify [post]
+func (h *UserHandler) VerifyEmail(c *gin.Context) {
+	var req model.VerifyEmailModel
+	if err := c.ShouldBindJSON(&req); err != nil || req.Token == "" {
+		c.Error(apperror.ErrInvalidRequest.WithDetail("token is required"))
 		return
 	}
-	user.UserID = id
 
-	updated, err := h.Service.UpdateUser(&user)
+	user, err := h.Service.ConfirmEmailChange(c.Request.Context(), req.Token)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, model.ErrorResponse{Message: "유저 정보 수정에 실패하였습니다", Detail: err.Error(), Status: 500})
+		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, model.UserResponse{Message: "유저 정보를 성공적으로 수정하였습니다", Status: 200, User: *updated})
+	c.JSON(http.StatusOK, model.UserResponse{Message: i18n.T(c, i18n.MsgEmailChanged), Status: 200, User: *user})
+}
+
+// ChangePassword godoc
+// @Summary 비밀번호 변경
+// @Description 현재 비밀번호를 확인한 뒤 비밀번호를 변경합니다. 현재 로그인을 제외한 다른 로그인의 refresh token 과 등록된 기기는 모두 삭제됩니다.
+// @Tags 사용자
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param password body model.ChangePasswordModel true "현재 비밀번호와 새 비밀번호"
+// @Success 200 {object} model.OKResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /users/password [put]
+func (h *UserHandler) ChangePassword(c *gin.Context) {
+	claims := c.MustGet("claims").(*model.BridgerClaims)
+	var req model.ChangePasswordModel
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apperror.ErrInvalidRequest.WithDetail("%v", err))
+		return
+	}
+
+	if err := h.Service.ChangePassword(c.Request.Context(), claims, &req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.OKResponse{Message: i18n.T(c, i18n.MsgPasswordChanged), Status: 200})
 }
 
 // DeleteUser godoc
 // @Summary 사용자 삭제
-// @Description JWT 토큰 기반으로 현재 사용자를 삭제합니다.
+// @Description 현재 사용자를 탈퇴 처리합니다. 모든 로그인이 폐기되고 참여 중인 채팅방에서 나가며, 소유한 채팅방은 다른 멤버에게 넘어가거나 남은 멤버가 없으면 삭제됩니다. 보낸 메세지는 다른 멤버를 위해 남습니다.
 // @Tags 사용자
 // @Security BearerAuth
 // @Success 200 {object} model.OKResponse
+// @Failure 409 {object} model.ErrorResponse "다른 사용자가 남은 회사의 마지막 관리자"
 // @Failure 500 {object} model.ErrorResponse
 // @Router /users [delete]
 func (h *UserHandler) DeleteUser(c *gin.Context) {
-	id := c.MustGet("userID").(string)
-	if err := h.Service.DeleteUser(id); err != nil {
-		c.JSON(http.StatusInternalServerError, model.ErrorResponse{Message: "삭제 실패", Detail: err.Error(), Status: 500})
+	claims := c.MustGet("claims").(*model.BridgerClaims)
+	if err := h.Service.DeleteUser(c.Request.Context(), claims); err != nil {
+		c.Error(err)
 		return
 	}
-	c.JSON(http.StatusOK, model.OKResponse{Message: "삭제 완료", Status: 200})
+	c.JSON(http.StatusOK, model.OKResponse{Message: i18n.T(c, i18n.MsgUserDeleted), Status: 200})
 }
 
 // Login godoc
@@ -137,17 +252,151 @@ func (h *UserHandler) DeleteUser(c *gin.Context) {
 func (h *UserHandler) Login(c *gin.Context) {
 	var req LoginRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, model.ErrorResponse{Message: "요청 형식이 잘못되었습니다", Detail: err.Error(), Status: 400})
+		c.Error(apperror.ErrInvalidRequest.WithDetail("%v", err))
+		return
+	}
+
+	user, tokens, err := h.Service.Authenticate(c.Request.Context(), req.Email, req.Password)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.TokenResponse{Message: i18n.T(c, i18n.MsgLoggedIn), Status: 200, User: *user, Token: tokens.AccessToken, RefreshToken: tokens.RefreshToken})
+}
+
+// RefreshToken godoc
+// @Summary 토큰 재발급
+// @Description refresh token 으로 새로운 access token 과 refresh token 을 발급합니다. 사용한 refresh token 은 폐기되며, 폐기된 토큰을 다시 사용하면 같은 로그인의 모든 refresh token 이 폐기됩니다.
+// @Tags 인증
+// @Accept json
+// @Produce json
+// @Param token body RefreshTokenRequest true "refresh token"
+// @Success 200 {object} model.RefreshTokenResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /token/refresh [post]
+func (h *UserHandler) RefreshToken(c *gin.Context) {
+	var req RefreshTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.RefreshToken == "" {
+		c.Error(apperror.ErrInvalidRequest.WithDetail("refreshToken is required"))
+		return
+	}
+
+	tokens, err := h.Service.RefreshToken(c.Request.Context(), req.RefreshToken)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.RefreshTokenResponse{Message: i18n.T(c, i18n.MsgTokenRefreshed), Status: 200, Token: tokens.AccessToken, RefreshToken: tokens.RefreshToken})
+}
+
+// Logout godoc
+// @Summary 로그아웃
+// @Description 현재 access token 과 같은 로그인에서 발급된 refresh token 을 모두 폐기합니다.
+// @Tags 인증
+// @Security BearerAuth
+// @Success 200 {object} model.OKResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /logout [post]
+func (h *UserHandler) Logout(c *gin.Context) {
+	claims := c.MustGet("claims").(*model.BridgerClaims)
+	if err := h.Service.Logout(c.Request.Context(), claims); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.OKResponse{Message: i18n.T(c, i18n.MsgLoggedOut), Status: 200})
+}
+
+// RegisterDevice godoc
+// @Summary 푸시 알림 기기 등록
+// @Description 현재 로그인에 푸시 알림을 받을 기기를 등록합니다. 같은 pushToken 이 이미 등록되어 있으면 갱신합니다.
+// @Description 로그아웃하거나 로그인이 폐기되면 등록된 기기도 함께 삭제됩니다.
+// @Tags 사용자
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param device body model.RegisterDeviceModel true "기기 정보"
+// @Success 200 {object} model.DeviceResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /users/devices [post]
+func (h *UserHandler) RegisterDevice(c *gin.Context) {
+	claims := c.MustGet("claims").(*model.BridgerClaims)
+	var req model.RegisterDeviceModel
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apperror.ErrInvalidRequest.WithDetail("%v", err))
 		return
 	}
 
-	user, token, err := h.Service.Authenticate(req.Email, req.Password)
+	device, err := h.Service.RegisterDevice(c.Request.Context(), claims.UserID, claims.SessionID, &req)
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, model.ErrorResponse{Message: "로그인에 실패하였습니다", Detail: err.Error(), Status: 401})
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.DeviceResponse{Message: i18n.T(c, i18n.MsgDeviceRegistered), Status: 200, Device: *device})
+}
+
+// GetDevices godoc
+// @Summary 푸시 알림 기기 목록 조회
+// @Description 사용자가 등록한 기기 목록을 최근 사용 순으로 조회합니다.
+// @Tags 사용자
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} model.DevicesResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /users/devices [get]
+func (h *UserHandler) GetDevices(c *gin.Context) {
+	id := c.MustGet("userID").(string)
+	devices, err := h.Service.GetDevices(c.Request.Context(), id)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.DevicesResponse{Message: i18n.T(c, i18n.MsgDevicesFetched), Status: 200, Devices: devices})
+}
+
+// RemoveSessionDevices godoc
+// @Summary 현재 기기 등록 해제
+// @Description 현재 로그인에서 등록한 기기를 삭제합니다. 로그인은 유지됩니다.
+// @Tags 사용자
+// @Security BearerAuth
+// @Success 200 {object} model.OKResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /users/devices [delete]
+func (h *UserHandler) RemoveSessionDevices(c *gin.Context) {
+	claims := c.MustGet("claims").(*model.BridgerClaims)
+	if err := h.Service.RemoveSessionDevices(c.Request.Context(), claims.SessionID); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.OKResponse{Message: i18n.T(c, i18n.MsgDeviceRemoved), Status: 200})
+}
+
+// RemoveDevice godoc
+// @Summary 기기 등록 해제
+// @Description 사용자가 등록한 기기를 삭제합니다. 다른 기기의 알림을 끌 때 사용합니다.
+// @Tags 사용자
+// @Security BearerAuth
+// @Param deviceID path string true "기기 고유 ID"
+// @Success 200 {object} model.OKResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /users/devices/{deviceID} [delete]
+func (h *UserHandler) RemoveDevice(c *gin.Context) {
+	id := c.MustGet("userID").(string)
+	if err := h.Service.RemoveDevice(c.Request.Context(), id, c.Param("deviceID")); err != nil {
+		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, model.TokenResponse{Message: "로그인에 성공하였습니다", Status: 200, User: *user, Token: token})
+	c.JSON(http.StatusOK, model.OKResponse{Message: i18n.T(c, i18n.MsgDeviceRemoved), Status: 200})
 }
 
 // UploadProfileImage godoc
@@ -167,7 +416,7 @@ func (h *UserHandler) UploadProfileImage(c *gin.Context) {
 
 	file, err := c.FormFile("image")
 	if err != nil {
-		c.JSON(http.StatusBadRequest, model.ErrorResponse{Message: "이미지 파일이 없습니다", Detail: err.Error(), Status: 400})
+		c.Error(apperror.ErrInvalidRequest.WithDetail("이미지 파일이 없습니다: %v", err))
 		return
 	}
 
@@ -175,15 +424,15 @@ func (h *UserHandler) UploadProfileImage(c *gin.Context) {
 	savePath := "static/uploads/" + filename
 
 	if err := c.SaveUploadedFile(file, savePath); err != nil {
-		c.JSON(http.StatusInternalServerError, model.ErrorResponse{Message: "이미지 저장에 실패하였습니다", Detail: err.Error(), Status: 500})
+		c.Error(apperror.Internal(err))
 		return
 	}
 
 	imageURL := "/static/uploads/" + filename
-	if err := h.Service.UpdateProfileImage(id, imageURL); err != nil {
-		c.JSON(http.StatusInternalServerError, model.ErrorResponse{Message: "이미지 저장에 실패하였습니다", Detail: err.Error(), Status: 500})
+	if err := h.Service.UpdateProfileImage(c.Request.Context(), id, imageURL); err != nil {
+		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, model.OKResponse{Message: "이미지를 성공적으로 저장하였습니다", Status: 200})
+	c.JSON(http.StatusOK, model.OKResponse{Message: i18n.T(c, i18n.MsgProfileImageSaved), Status: 200})
 }
@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/B-Bridger/server/hub"
+	"github.com/B-Bridger/server/logging"
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/service"
+	"github.com/B-Bridger/server/tracing"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type WebSocketHandler struct {
+	Hub             *hub.Hub
+	UserService     *service.UserService
+	ChatRoomService *service.ChatRoomService
+	MessageService  *service.MessageService
+	// WebSocket 연결을 허용하는 Origin (config.Server.AllowedOrigins)
+	// 비어 있으면 서버와 같은 호스트의 Origin 만 허용하고, "*" 이면 모든 Origin 을 허용합니다.
+	AllowedOrigins []string
+}
+
+// upgrader는 Origin 을 AllowedOrigins 로 검증하는 upgrader 를 반환합니다.
+// 쿠키가 아닌 JWT 로 인증하지만, 허용하지 않은 사이트의 페이지가 토큰을 이용해 연결하는 것을 막습니다.
+func (h *WebSocketHandler) upgrader() *websocket.Upgrader {
+	return &websocket.Upgrader{
+		ReadBufferSize:  1024,
+		WriteBufferSize: 1024,
+		Subprotocols:    []string{model.WebSocketProtocol},
+		CheckOrigin:     h.checkOrigin,
+	}
+}
+
+// checkOrigin은 요청의 Origin 헤더가 허용된 Origin 인지 확인합니다.
+// Origin 헤더가 없는 요청은 브라우저가 아닌 클라이언트이므로 허용합니다.
+func (h *WebSocketHandler) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	if len(h.AllowedOrigins) == 0 {
+		u, err := url.Parse(origin)
+		return err == nil && strings.EqualFold(u.Host, r.Host)
+	}
+	for _, allowed := range h.AllowedOrigins {
+		if allowed == "*" || strings.EqualFold(strings.TrimSuffix(allowed, "/"), origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// Connect godoc
+// @Summary 채팅방 실시간 연결
+// @Description 채팅방 멤버만 채팅방 WebSocket 에 연결할 수 있습니다. 새로운 메세지(사용자의 언어로 번역), 입장, 퇴장 이벤트가 실시간으로 전달되며, {"type":"message","content":"..."} 형식으로 메세지를 보낼 수 있습니다.
+// @Tags 메세지
+// @Security BearerAuth
+// @Param id path string true "연결할 채팅방 고유 ID"
+// @Param Sec-WebSocket-Protocol header string false "Authorization 헤더를 사용할 수 없는 경우 \"bridger, bearer.<JWT>\""
+// @Param token query string false "(deprecated) Sec-WebSocket-Protocol 을 사용할 수 없는 이전 클라이언트의 JWT 토큰"
+// @Success 101
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Router /chat-room/{id}/ws [get]
+func (h *WebSocketHandler) Connect(c *gin.Context) {
+	userID := c.MustGet("userID").(string)
+	chatRoomID := c.Param("id")
+	if _, err := h.ChatRoomService.GetChatRoomForMember(c.Request.Context(), chatRoomID, userID); err != nil {
+		c.Error(err)
+		return
+	}
+	user, err := h.UserService.GetUser(c.Request.Context(), userID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	// Upgrade 실패 시 upgrader 가 직접 오류 응답을 작성합니다.
+	conn, err := h.upgrader().Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		return
+	}
+
+	client := hub.NewClient(h.Hub, conn, chatRoomID, userID, user.Language, h.handleCommand)
+	client.Run()
+}
+
+func (h *WebSocketHandler) handleCommand(client *hub.Client, cmd *model.ChatCommand) {
+	// 연결 요청의 span 은 연결이 끊길 때까지 이어지므로, 명령마다 새로운 trace 를 시작합니다.
+	ctx, span := tracing.Start(context.Background(), "WebSocket "+cmd.Type, trace.WithAttributes(attribute.String("chatRoom.id", client.ChatRoomID)))
+	defer span.End()
+	ctx = logging.With(ctx, slog.String("userID", client.UserID))
+
+	switch cmd.Type {
+	case model.ChatEventMessage:
+		req := model.CreateMessageModel{Content: cmd.Content, Language: cmd.Language}
+		if _, err := h.MessageService.PostMessage(ctx, client.ChatRoomID, client.UserID, &req); err != nil {
+			// 연결한 뒤에 채팅방에서 나간 경우 메세지를 보낼 수 없으며, 연결을 끊습니다.
+			if errors.Is(err, service.ErrNotChatRoomMember) {
+				client.Send(&model.ChatEvent{Type: model.ChatEventError, ChatRoomID: client.ChatRoomID, Detail: "not a chat room member"})
+				h.Hub.Unregister(client)
+				return
+			}
+			detail := "failed to post message"
+			if errors.Is(err, service.ErrEmptyMessage) {
+				detail = err.Error()
+			}
+			client.Send(&model.ChatEvent{Type: model.ChatEventError, ChatRoomID: client.ChatRoomID, Detail: detail})
+		}
+	default:
+		client.Send(&model.ChatEvent{Type: model.ChatEventError, ChatRoomID: client.ChatRoomID, Detail: "unknown event type"})
+	}
+}
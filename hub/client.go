@@ -0,0 +1,221 @@
+package hub
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/B-Bridger/server/model"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// 메세지 하나를 쓰는 데 허용되는 시간
+	writeWait = 10 * time.Second
+	// 상대방의 pong 을 기다리는 시간
+	pongWait = 60 * time.Second
+	// ping 전송 주기 (pongWait 보다 짧아야 합니다)
+	pingPeriod = (pongWait * 9) / 10
+	// 클라이언트가 보낼 수 있는 최대 메세지 크기
+	maxMessageSize = 8 * 1024
+	// 클라이언트별 전송 버퍼 크기, 가득 차면 연결을 끊습니다
+	sendBufferSize = 256
+	// 처리를 기다리는 요청의 최대 개수, 가득 차면 새로운 요청을 거절합니다
+	commandBufferSize = 16
+)
+
+// Client는 하나의 WebSocket 연결을 나타냅니다.
+type Client struct {
+	ChatRoomID string
+	UserID     string
+	// 메세지를 전달받을 언어, 비어있으면 원문을 전달합니다.
+	Language string
+
+	hub  *Hub
+	conn *websocket.Conn
+	// send 는 닫지 않습니다. 연결을 끝낼 때는 mu 를 잡고 closed 를 표시한 뒤 done 을 닫으며,
+	// enqueue 는 같은 mu 안에서 closed 를 확인하므로 끝난 클라이언트의 버퍼에는 더 이상 넣지 않습니다.
+	send   chan []byte
+	mu     sync.Mutex
+	closed bool
+	done   chan struct{}
+
+	// readPump 가 받은 요청을 commandWorker 에 넘기는 채널, readPump 가 끝나면 닫습니다.
+	commands  chan *model.ChatCommand
+	onCommand func(c *Client, cmd *model.ChatCommand)
+}
+
+// NewClient는 연결된 WebSocket 으로 새로운 Client 를 생성합니다.
+// onCommand 는 클라이언트가 보낸 요청마다 별도의 goroutine 에서 받은 순서대로 호출됩니다.
+func NewClient(h *Hub, conn *websocket.Conn, chatRoomID, userID, language string, onCommand func(c *Client, cmd *model.ChatCommand)) *Client {
+	return &Client{
+		ChatRoomID: chatRoomID,
+		UserID:     userID,
+		Language:   language,
+		hub:        h,
+		conn:       conn,
+		send:       make(chan []byte, sendBufferSize),
+		done:       make(chan struct{}),
+		commands:   make(chan *model.ChatCommand, commandBufferSize),
+		onCommand:  onCommand,
+	}
+}
+
+// Run은 클라이언트를 Hub 에 등록하고 연결이 끊길 때까지 읽기를 처리합니다.
+// 반환 시점에는 Hub 에서 제거되어 있고, 처리 중이던 요청과 쓰기 goroutine 도 끝나 있습니다.
+// Hub 가 종료 중이면 연결을 바로 닫습니다.
+func (c *Client) Run() {
+	if !c.hub.track() {
+		c.conn.Close()
+		return
+	}
+	defer c.hub.running.Done()
+
+	if !c.hub.Register(c) {
+		c.conn.Close()
+		return
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		c.writePump()
+	}()
+	go func() {
+		defer wg.Done()
+		c.commandWorker()
+	}()
+	c.readPump()
+	wg.Wait()
+}
+
+// Send는 해당 클라이언트에게만 이벤트를 전달합니다.
+func (c *Client) Send(event *model.ChatEvent) {
+	if event.SentAt.IsZero() {
+		event.SentAt = time.Now()
+	}
+	data, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+	if !c.enqueue(data) {
+		c.hub.Unregister(c)
+	}
+}
+
+// enqueue는 전송 버퍼에 여유가 있을 때만 데이터를 넣습니다.
+// 이미 Hub 에서 제거된 클라이언트이면 데이터를 버리고 true 를 반환합니다.
+func (c *Client) enqueue(data []byte) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return true
+	}
+
+	select {
+	case c.send <- data:
+		return true
+	default:
+		return false
+	}
+}
+
+// close는 클라이언트를 끝난 것으로 표시하고 writePump 에 알립니다.
+// writePump 는 버퍼에 남은 데이터를 보낸 뒤 close 메세지를 보내고 연결을 닫습니다. 여러 번 호출되어도 안전합니다.
+func (c *Client) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return
+	}
+	c.closed = true
+	close(c.done)
+}
+
+func (c *Client) readPump() {
+	defer func() {
+		close(c.commands)
+		c.hub.Unregister(c)
+		c.conn.Close()
+	}()
+
+	c.conn.SetReadLimit(maxMessageSize)
+	_ = c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	for {
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		var cmd model.ChatCommand
+		if err := json.Unmarshal(data, &cmd); err != nil {
+			c.Send(&model.ChatEvent{Type: model.ChatEventError, ChatRoomID: c.ChatRoomID, Detail: "invalid message format"})
+			continue
+		}
+
+		// 요청 처리(번역 등)가 오래 걸려도 pong 과 다음 요청을 계속 읽을 수 있도록 commandWorker 에 넘깁니다.
+		select {
+		case c.commands <- &cmd:
+		default:
+			c.Send(&model.ChatEvent{Type: model.ChatEventError, ChatRoomID: c.ChatRoomID, Detail: "too many requests"})
+		}
+	}
+}
+
+// commandWorker는 readPump 가 넘긴 요청을 받은 순서대로 처리합니다.
+// 연결이 끊겨도 이미 받은 요청은 마저 처리합니다.
+func (c *Client) commandWorker() {
+	for cmd := range c.commands {
+		if c.onCommand != nil {
+			c.onCommand(c, cmd)
+		}
+	}
+}
+
+func (c *Client) writePump() {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		c.conn.Close()
+	}()
+
+	for {
+		select {
+		case data := <-c.send:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(websocket.TextMessage, data); err != nil {
+				return
+			}
+		case <-c.done:
+			// Hub 에서 제거된 경우
+			c.flush()
+			return
+		case <-ticker.C:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// flush는 close 전에 전송 버퍼에 들어간 데이터를 모두 보낸 뒤 close 메세지를 보냅니다.
+func (c *Client) flush() {
+	_ = c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+	for {
+		select {
+		case data := <-c.send:
+			if err := c.conn.WriteMessage(websocket.TextMessage, data); err != nil {
+				return
+			}
+		default:
+			_ = c.conn.WriteMessage(websocket.CloseMessage, []byte{})
+			return
+		}
+	}
+}
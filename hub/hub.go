@@ -0,0 +1,232 @@
+package hub
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/B-Bridger/server/model"
+)
+
+// Hub는 채팅방별로 접속 중인 WebSocket 클라이언트를 관리하고
+// 이벤트를 같은 채팅방의 모든 참여자에게 전달합니다.
+//
+// 채팅방마다 별도의 goroutine 을 두지 않으며, 마지막 클라이언트가 나가면
+// 채팅방 항목도 함께 제거되므로 접속이 끊긴 채팅방이 남지 않습니다.
+type Hub struct {
+	mu    sync.RWMutex
+	rooms map[string]map[*Client]struct{}
+	// Shutdown 이 호출된 뒤에는 새로운 클라이언트를 받지 않습니다.
+	closed bool
+	// 실행 중인 클라이언트 (Client.Run)
+	running sync.WaitGroup
+}
+
+func New() *Hub {
+	return &Hub{rooms: make(map[string]map[*Client]struct{})}
+}
+
+// Register는 클라이언트를 채팅방에 등록하고 join 이벤트를 전달합니다.
+// Hub 가 종료 중이면 등록하지 않고 false 를 반환합니다.
+func (h *Hub) Register(c *Client) bool {
+	h.mu.Lock()
+	if h.closed {
+		h.mu.Unlock()
+		return false
+	}
+	clients, ok := h.rooms[c.ChatRoomID]
+	if !ok {
+		clients = make(map[*Client]struct{})
+		h.rooms[c.ChatRoomID] = clients
+	}
+	clients[c] = struct{}{}
+	h.mu.Unlock()
+
+	h.Broadcast(c.ChatRoomID, &model.ChatEvent{Type: model.ChatEventJoin, ChatRoomID: c.ChatRoomID, UserID: c.UserID})
+	return true
+}
+
+// Unregister는 클라이언트를 채팅방에서 제거하고 leave 이벤트를 전달합니다.
+// 여러 번 호출되어도 안전합니다.
+func (h *Hub) Unregister(c *Client) {
+	h.mu.Lock()
+	clients, ok := h.rooms[c.ChatRoomID]
+	if !ok {
+		h.mu.Unlock()
+		return
+	}
+	if _, ok := clients[c]; !ok {
+		h.mu.Unlock()
+		return
+	}
+	delete(clients, c)
+	if len(clients) == 0 {
+		delete(h.rooms, c.ChatRoomID)
+	}
+	h.mu.Unlock()
+
+	c.close()
+	h.Broadcast(c.ChatRoomID, &model.ChatEvent{Type: model.ChatEventLeave, ChatRoomID: c.ChatRoomID, UserID: c.UserID})
+}
+
+// Disconnect는 사용자가 채팅방에 접속한 모든 클라이언트를 제거하고 연결을 닫습니다.
+// 채팅방에서 나간 멤버가 이후의 이벤트를 받지 않도록 할 때 사용합니다.
+func (h *Hub) Disconnect(chatRoomID, userID string) {
+	var clients []*Client
+	h.mu.RLock()
+	for c := range h.rooms[chatRoomID] {
+		if c.UserID == userID {
+			clients = append(clients, c)
+		}
+	}
+	h.mu.RUnlock()
+
+	for _, c := range clients {
+		h.Unregister(c)
+	}
+}
+
+// Broadcast는 이벤트를 채팅방에 접속한 모든 클라이언트에게 전달합니다.
+func (h *Hub) Broadcast(chatRoomID string, event *model.ChatEvent) {
+	if event.SentAt.IsZero() {
+		event.SentAt = time.Now()
+	}
+	data, err := json.Marshal(event)
+	if err != nil {
+		slog.Error("WebSocket 이벤트 직렬화 실패", slog.Any("error", err))
+		return
+	}
+
+	h.fanOut(chatRoomID, func(*Client) []byte {
+		return data
+	})
+}
+
+// BroadcastMessage는 메세지를 채팅방에 접속한 클라이언트마다 각자의 Language 로 변환하여 전달합니다.
+// 직렬화는 언어별로 한 번만 수행합니다.
+func (h *Hub) BroadcastMessage(chatRoomID string, message *model.Message) {
+	sentAt := time.Now()
+	byLanguage := make(map[string][]byte)
+
+	h.fanOut(chatRoomID, func(c *Client) []byte {
+		if data, ok := byLanguage[c.Language]; ok {
+			return data
+		}
+
+		view := message.Localize(c.Language, false)
+		data, err := json.Marshal(&model.ChatEvent{Type: model.ChatEventMessage, ChatRoomID: chatRoomID, UserID: message.UserID, ChatMessage: &view, SentAt: sentAt})
+		if err != nil {
+			slog.Error("WebSocket 이벤트 직렬화 실패", slog.Any("error", err))
+			return nil
+		}
+		byLanguage[c.Language] = data
+		return data
+	})
+}
+
+// fanOut은 render 가 만든 데이터를 채팅방의 각 클라이언트 전송 버퍼에 넣습니다.
+// 전송 버퍼가 가득 찬 클라이언트는 처리 속도가 느린 것으로 보고 연결을 끊습니다.
+func (h *Hub) fanOut(chatRoomID string, render func(c *Client) []byte) {
+	var slow []*Client
+	h.mu.RLock()
+	for c := range h.rooms[chatRoomID] {
+		data := render(c)
+		if data == nil {
+			continue
+		}
+		if !c.enqueue(data) {
+			slow = append(slow, c)
+		}
+	}
+	h.mu.RUnlock()
+
+	for _, c := range slow {
+		h.Unregister(c)
+	}
+}
+
+// Count는 채팅방에 접속 중인 클라이언트 수를 반환합니다.
+func (h *Hub) Count(chatRoomID string) int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.rooms[chatRoomID])
+}
+
+// Connections는 모든 채팅방에 접속 중인 클라이언트 수를 반환합니다.
+func (h *Hub) Connections() int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	n := 0
+	for _, clients := range h.rooms {
+		n += len(clients)
+	}
+	return n
+}
+
+// IsConnected는 사용자가 채팅방에 WebSocket 으로 접속 중인지 반환합니다.
+func (h *Hub) IsConnected(chatRoomID, userID string) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for c := range h.rooms[chatRoomID] {
+		if c.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// track은 Shutdown 이 기다릴 수 있도록 실행 중인 클라이언트 수를 늘립니다.
+// Hub 가 종료 중이면 false 를 반환합니다.
+func (h *Hub) track() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		return false
+	}
+	h.running.Add(1)
+	return true
+}
+
+// Shutdown은 새로운 클라이언트를 받지 않고, 접속 중인 모든 클라이언트에게 close 메세지를 보낸 뒤
+// 처리 중인 요청을 마치고 연결이 끊길 때까지 기다립니다.
+// ctx 가 끝날 때까지 끊기지 않은 연결은 강제로 닫습니다.
+//
+// 매개 변수
+//   - ctx: 대기 기한
+//
+// 반환 값
+//   - error: 기한 내에 모든 연결이 끊기지 않으면 ctx.Err()
+func (h *Hub) Shutdown(ctx context.Context) error {
+	h.mu.Lock()
+	h.closed = true
+	var clients []*Client
+	for _, room := range h.rooms {
+		for c := range room {
+			clients = append(clients, c)
+		}
+	}
+	h.rooms = make(map[string]map[*Client]struct{})
+	h.mu.Unlock()
+
+	for _, c := range clients {
+		c.close()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		h.running.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		for _, c := range clients {
+			c.conn.Close()
+		}
+		return ctx.Err()
+	}
+}
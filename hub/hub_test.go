@@ -0,0 +1,268 @@
+package hub
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/B-Bridger/server/model"
+	"github.com/gorilla/websocket"
+)
+
+const testRoom = "room"
+
+// testServer는 ?user= 로 전달된 사용자를 testRoom 에 연결하는 WebSocket 서버입니다.
+type testServer struct {
+	hub *Hub
+	url string
+	// 실행 중인 Client.Run
+	runs sync.WaitGroup
+}
+
+func newTestServer(t *testing.T, onCommand func(c *Client, cmd *model.ChatCommand)) *testServer {
+	t.Helper()
+
+	s := &testServer{hub: New()}
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		s.runs.Add(1)
+		defer s.runs.Done()
+		NewClient(s.hub, conn, testRoom, r.URL.Query().Get("user"), "", onCommand).Run()
+	}))
+	t.Cleanup(srv.Close)
+	s.url = "ws" + strings.TrimPrefix(srv.URL, "http") + "/?user="
+	return s
+}
+
+// connect는 사용자를 연결하고 Hub 에 등록될 때까지 기다립니다.
+func (s *testServer) connect(t *testing.T, userID string) *websocket.Conn {
+	t.Helper()
+
+	conn, _, err := websocket.DefaultDialer.Dial(s.url+userID, nil)
+	if err != nil {
+		t.Fatalf("연결 실패: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	eventually(t, func() bool { return s.hub.IsConnected(testRoom, userID) })
+	return conn
+}
+
+// readEvent는 주어진 종류의 이벤트가 올 때까지 읽습니다.
+func readEvent(t *testing.T, conn *websocket.Conn, eventType string) model.ChatEvent {
+	t.Helper()
+
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	for {
+		var event model.ChatEvent
+		if err := conn.ReadJSON(&event); err != nil {
+			t.Fatalf("이벤트 수신 실패: %v", err)
+		}
+		if event.Type == eventType {
+			return event
+		}
+	}
+}
+
+// eventually는 cond 가 true 가 될 때까지 최대 5초 기다립니다.
+func eventually(t *testing.T, cond func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("조건을 만족하지 못했습니다")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestUnregisterOnDisconnect(t *testing.T) {
+	s := newTestServer(t, nil)
+	alice := s.connect(t, "alice")
+	bob := s.connect(t, "bob")
+	if s.hub.Count(testRoom) != 2 {
+		t.Fatalf("count = %d, want 2", s.hub.Count(testRoom))
+	}
+
+	bob.Close()
+	if event := readEvent(t, alice, model.ChatEventLeave); event.UserID != "bob" {
+		t.Fatalf("event = %+v", event)
+	}
+	if s.hub.IsConnected(testRoom, "bob") || s.hub.Count(testRoom) != 1 {
+		t.Fatalf("연결이 끊긴 클라이언트가 남아 있습니다: count = %d", s.hub.Count(testRoom))
+	}
+
+	alice.Close()
+	eventually(t, func() bool { return s.hub.Connections() == 0 })
+	s.hub.mu.RLock()
+	defer s.hub.mu.RUnlock()
+	if len(s.hub.rooms) != 0 {
+		t.Fatalf("빈 채팅방 항목이 남아 있습니다: %v", s.hub.rooms)
+	}
+}
+
+func TestSlowConsumer(t *testing.T) {
+	h := New()
+	// 쓰기 goroutine 이 없는 클라이언트는 전송 버퍼를 비우지 않습니다.
+	slow := NewClient(h, nil, testRoom, "slow", "", nil)
+	if !h.Register(slow) {
+		t.Fatal("등록 실패")
+	}
+
+	for i := 0; i < sendBufferSize; i++ {
+		h.Broadcast(testRoom, &model.ChatEvent{Type: model.ChatEventMessage, ChatRoomID: testRoom})
+	}
+	if h.IsConnected(testRoom, "slow") {
+		t.Fatal("전송 버퍼가 가득 찬 클라이언트가 제거되지 않았습니다")
+	}
+	select {
+	case <-slow.done:
+	default:
+		t.Fatal("제거된 클라이언트의 연결 종료가 요청되지 않았습니다")
+	}
+
+	// 제거된 클라이언트에 보내는 것은 아무 일도 하지 않습니다.
+	slow.Send(&model.ChatEvent{Type: model.ChatEventMessage, ChatRoomID: testRoom})
+	if len(slow.send) != sendBufferSize {
+		t.Fatalf("buffered = %d, want %d", len(slow.send), sendBufferSize)
+	}
+}
+
+func TestConcurrentBroadcastAndUnregister(t *testing.T) {
+	h := New()
+	clients := make([]*Client, 20)
+	for i := range clients {
+		clients[i] = NewClient(h, nil, testRoom, "user", "", nil)
+		h.Register(clients[i])
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				h.Broadcast(testRoom, &model.ChatEvent{Type: model.ChatEventMessage, ChatRoomID: testRoom})
+				for _, c := range clients {
+					c.Send(&model.ChatEvent{Type: model.ChatEventMessage, ChatRoomID: testRoom})
+				}
+			}
+		}()
+	}
+	for _, c := range clients {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			h.Unregister(c)
+		}()
+	}
+	wg.Wait()
+
+	if h.Connections() != 0 {
+		t.Fatalf("connections = %d, want 0", h.Connections())
+	}
+}
+
+func TestCommandWorker(t *testing.T) {
+	release := make(chan struct{})
+	handled := make(chan string, 2)
+	s := newTestServer(t, func(c *Client, cmd *model.ChatCommand) {
+		<-release
+		handled <- cmd.Content
+	})
+	conn := s.connect(t, "alice")
+
+	for _, content := range []string{"first", "second"} {
+		if err := conn.WriteJSON(model.ChatCommand{Type: model.ChatEventMessage, Content: content}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// 요청 처리가 끝나지 않아도 다음 요청을 계속 읽습니다.
+	if err := conn.WriteMessage(websocket.TextMessage, []byte("not json")); err != nil {
+		t.Fatal(err)
+	}
+	if event := readEvent(t, conn, model.ChatEventError); event.Detail != "invalid message format" {
+		t.Fatalf("event = %+v", event)
+	}
+
+	close(release)
+	for _, want := range []string{"first", "second"} {
+		select {
+		case got := <-handled:
+			if got != want {
+				t.Fatalf("handled = %q, want %q", got, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("요청이 처리되지 않았습니다")
+		}
+	}
+}
+
+func TestShutdown(t *testing.T) {
+	var handled atomic.Bool
+	s := newTestServer(t, func(c *Client, cmd *model.ChatCommand) {
+		time.Sleep(100 * time.Millisecond)
+		handled.Store(true)
+	})
+	before := runtime.NumGoroutine()
+
+	conns := []*websocket.Conn{s.connect(t, "alice"), s.connect(t, "bob"), s.connect(t, "carol")}
+	if err := conns[0].WriteJSON(model.ChatCommand{Type: model.ChatEventMessage, Content: "bye"}); err != nil {
+		t.Fatal(err)
+	}
+	// 요청이 commandWorker 에 넘어갈 때까지 기다립니다.
+	time.Sleep(20 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.hub.Shutdown(ctx); err != nil {
+		t.Fatalf("shutdown: %v", err)
+	}
+	if !handled.Load() {
+		t.Fatal("처리 중인 요청을 기다리지 않고 종료되었습니다")
+	}
+	if s.hub.Connections() != 0 {
+		t.Fatalf("connections = %d, want 0", s.hub.Connections())
+	}
+
+	// 모든 클라이언트는 close 메세지를 받습니다.
+	for _, conn := range conns {
+		conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		for {
+			_, _, err := conn.ReadMessage()
+			if err == nil {
+				continue
+			}
+			if !websocket.IsCloseError(err, websocket.CloseNoStatusReceived) {
+				t.Fatalf("close 메세지 대신 %v", err)
+			}
+			break
+		}
+		conn.Close()
+	}
+
+	// 종료 후에는 등록되지 않고 바로 연결이 닫힙니다.
+	late, _, err := websocket.DefaultDialer.Dial(s.url+"dave", nil)
+	if err != nil {
+		t.Fatalf("연결 실패: %v", err)
+	}
+	late.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if _, _, err := late.ReadMessage(); err == nil {
+		t.Fatal("종료 후 연결이 유지되었습니다")
+	}
+	late.Close()
+
+	// Run 과 읽기, 쓰기, 요청 처리 goroutine 이 모두 끝납니다.
+	s.runs.Wait()
+	eventually(t, func() bool { return runtime.NumGoroutine() <= before })
+}
@@ -0,0 +1,33 @@
+package i18n
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// 인증 middleware 가 사용자의 Language 를 저장하는 context 키
+const LanguageKey = "language"
+
+// Locale은 요청에 응답할 언어를 결정합니다.
+// 인증된 사용자의 Language, Accept-Language 헤더, DefaultLocale 순서로 지원하는 언어를 찾습니다.
+//
+// 매개 변수
+//   - c: 요청 context
+//
+// 반환 값
+//   - string: 언어 코드
+func Locale(c *gin.Context) string {
+	languages := append([]string{c.GetString(LanguageKey)}, ParseAcceptLanguage(c.GetHeader("Accept-Language"))...)
+	if locale := Match(languages...); locale != "" {
+		return locale
+	}
+	return DefaultLocale
+}
+
+// T는 요청 언어에 맞는 메세지를 반환합니다.
+//
+// 매개 변수
+//   - c: 요청 context
+//   - id: 메세지 ID
+func T(c *gin.Context, id string) string {
+	return Message(Locale(c), id)
+}
@@ -0,0 +1,154 @@
+// i18n 패키지는 API 응답 메세지의 다국어 카탈로그를 제공합니다.
+//
+// 메세지는 고정된 메세지 ID(예: user.fetched, error.user_not_found)로 찾으며,
+// 카탈로그 파일(locales/*.json)은 서버 바이너리에 포함됩니다.
+// 오류 메세지의 ID 는 "error." 뒤에 apperror 의 고정 코드를 붙인 값입니다.
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// 사용자의 언어, Accept-Language 모두 지원하지 않는 경우 사용하는 언어
+const DefaultLocale = "ko"
+
+//go:embed locales/*.json
+var localeFS embed.FS
+
+// 서버에 포함된 카탈로그
+var catalog = mustLoad()
+
+// Catalog는 언어별 메세지 목록입니다.
+type Catalog map[string]map[string]string
+
+// mustLoad는 포함된 카탈로그 파일을 모두 불러옵니다. 파일 이름(확장자 제외)이 언어 코드입니다.
+func mustLoad() Catalog {
+	files, err := localeFS.ReadDir("locales")
+	if err != nil {
+		panic(err)
+	}
+
+	c := make(Catalog, len(files))
+	for _, f := range files {
+		data, err := localeFS.ReadFile(path.Join("locales", f.Name()))
+		if err != nil {
+			panic(err)
+		}
+		var messages map[string]string
+		if err := json.Unmarshal(data, &messages); err != nil {
+			panic(fmt.Sprintf("i18n: %s: %v", f.Name(), err))
+		}
+		c[strings.TrimSuffix(f.Name(), path.Ext(f.Name()))] = messages
+	}
+	if _, ok := c[DefaultLocale]; !ok {
+		panic("i18n: 기본 언어(" + DefaultLocale + ") 카탈로그가 없습니다")
+	}
+	return c
+}
+
+// Locales는 지원하는 언어 코드 목록을 반환합니다.
+func Locales() []string {
+	locales := make([]string, 0, len(catalog))
+	for locale := range catalog {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// Match는 주어진 언어 중 처음으로 지원하는 언어를 반환합니다.
+// ko-KR 과 같이 지역이 포함된 언어는 지원하지 않으면 ko 로 비교합니다.
+//
+// 매개 변수
+//   - languages: 우선순위 순서의 언어 코드 목록
+//
+// 반환 값
+//   - string: 지원하는 언어 코드, 없으면 빈 문자열
+func Match(languages ...string) string {
+	for _, language := range languages {
+		tag := strings.ToLower(strings.TrimSpace(strings.ReplaceAll(language, "_", "-")))
+		if tag == "" {
+			continue
+		}
+		if _, ok := catalog[tag]; ok {
+			return tag
+		}
+		if base, _, found := strings.Cut(tag, "-"); found {
+			if _, ok := catalog[base]; ok {
+				return base
+			}
+		}
+	}
+	return ""
+}
+
+// ParseAcceptLanguage는 Accept-Language 헤더의 언어를 q 값이 높은 순서로 반환합니다.
+// q=0 인 언어와 "*" 는 제외합니다.
+//
+// 매개 변수
+//   - header: Accept-Language 헤더 값 (예: "en-US,en;q=0.9,ko;q=0.8")
+func ParseAcceptLanguage(header string) []string {
+	type weighted struct {
+		tag string
+		q   float64
+	}
+	var tags []weighted
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		tag = strings.TrimSpace(tag)
+		if tag == "" || tag == "*" {
+			continue
+		}
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q <= 0 {
+			continue
+		}
+		tags = append(tags, weighted{tag, q})
+	}
+
+	sort.SliceStable(tags, func(i, j int) bool { return tags[i].q > tags[j].q })
+	languages := make([]string, len(tags))
+	for i, t := range tags {
+		languages[i] = t.tag
+	}
+	return languages
+}
+
+// Lookup은 언어에 맞는 메세지를 반환합니다.
+// 해당 언어에 메세지가 없으면 기본 언어의 메세지를 사용합니다.
+//
+// 매개 변수
+//   - locale: 언어 코드 (Match 로 얻은 값)
+//   - id: 메세지 ID
+//
+// 반환 값
+//   - string: 메세지
+//   - bool: 카탈로그에 메세지가 있는지 여부
+func Lookup(locale, id string) (string, bool) {
+	if message, ok := catalog[locale][id]; ok {
+		return message, true
+	}
+	message, ok := catalog[DefaultLocale][id]
+	return message, ok
+}
+
+// Message는 언어에 맞는 메세지를 반환합니다. 카탈로그에 없으면 메세지 ID 를 그대로 반환합니다.
+func Message(locale, id string) string {
+	if message, ok := Lookup(locale, id); ok {
+		return message
+	}
+	return id
+}
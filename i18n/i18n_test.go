@@ -0,0 +1,65 @@
+package i18n
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCatalogsComplete(t *testing.T) {
+	base := catalog[DefaultLocale]
+	for _, locale := range Locales() {
+		for id := range base {
+			if catalog[locale][id] == "" {
+				t.Errorf("%s: %s 메세지가 없습니다", locale, id)
+			}
+		}
+		for id := range catalog[locale] {
+			if _, ok := base[id]; !ok {
+				t.Errorf("%s: %s 는 기본 언어에 없는 메세지입니다", locale, id)
+			}
+		}
+	}
+	for _, locale := range []string{"ko", "en", "ja"} {
+		if _, ok := catalog[locale]; !ok {
+			t.Errorf("%s 카탈로그가 없습니다", locale)
+		}
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		languages []string
+		want      string
+	}{
+		{[]string{"en"}, "en"},
+		{[]string{"ja-JP"}, "ja"},
+		{[]string{"EN_us"}, "en"},
+		{[]string{"", "fr", "ko-KR"}, "ko"},
+		{[]string{"fr"}, ""},
+	}
+	for _, tt := range tests {
+		if got := Match(tt.languages...); got != tt.want {
+			t.Errorf("Match(%q) = %q, want %q", tt.languages, got, tt.want)
+		}
+	}
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	got := ParseAcceptLanguage("ko;q=0.5, en-US, *, ja;q=0.8, fr;q=0, de;q=abc")
+	want := []string{"en-US", "ja", "ko"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ParseAcceptLanguage = %q, want %q", got, want)
+	}
+}
+
+func TestMessageFallback(t *testing.T) {
+	if got := Message("en", MsgUserFetched); got != "User retrieved successfully" {
+		t.Fatalf("en = %q", got)
+	}
+	if got := Message("xx", MsgUserFetched); got != catalog[DefaultLocale][MsgUserFetched] {
+		t.Fatalf("fallback = %q", got)
+	}
+	if got := Message("en", "unknown.id"); got != "unknown.id" {
+		t.Fatalf("unknown = %q", got)
+	}
+}
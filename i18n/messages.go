@@ -0,0 +1,55 @@
+package i18n
+
+// 응답 메세지 ID
+const (
+	MsgUserFetched               = "user.fetched"
+	MsgUserCreated               = "user.created"
+	MsgUserUpdated               = "user.updated"
+	MsgUserDeleted               = "user.deleted"
+	MsgProfileImageSaved         = "user.profile_image_saved"
+	MsgEmailVerificationSent     = "user.email_verification_sent"
+	MsgEmailChanged              = "user.email_changed"
+	MsgPasswordChanged           = "user.password_changed"
+	MsgLoggedIn                  = "auth.logged_in"
+	MsgTokenRefreshed            = "auth.token_refreshed"
+	MsgLoggedOut                 = "auth.logged_out"
+	MsgDeviceRegistered          = "device.registered"
+	MsgDevicesFetched            = "device.listed"
+	MsgDeviceRemoved             = "device.removed"
+	MsgChatRoomFetched           = "chat_room.fetched"
+	MsgChatRoomsFetched          = "chat_room.listed"
+	MsgChatRoomCreated           = "chat_room.created"
+	MsgChatRoomUpdated           = "chat_room.updated"
+	MsgChatRoomDeleted           = "chat_room.deleted"
+	MsgMembersAdded              = "chat_room.members_added"
+	MsgMembersRemoved            = "chat_room.members_removed"
+	MsgChatRoomMuted             = "chat_room.muted"
+	MsgChatRoomUnmuted           = "chat_room.unmuted"
+	MsgMessagesFetched           = "message.listed"
+	MsgMessageFetched            = "message.fetched"
+	MsgMessageSent               = "message.sent"
+	MsgCompanyCreated            = "company.created"
+	MsgCompanyFetched            = "company.fetched"
+	MsgCompanyUpdated            = "company.updated"
+	MsgCompanyUsersFetched       = "company.users_listed"
+	MsgCompanyInvitationSent     = "company.invitation_sent"
+	MsgCompanyInvitationsFetched = "company.invitations_listed"
+	MsgCompanyInvitationAccepted = "company.invitation_accepted"
+	MsgCompanyInvitationDeleted  = "company.invitation_deleted"
+	MsgCompanyMemberRoleUpdated  = "company.member_role_updated"
+	MsgCompanyMemberRemoved      = "company.member_removed"
+	MsgGlossaryFetched           = "glossary.listed"
+	MsgGlossaryTermCreated       = "glossary.term_created"
+	MsgGlossaryTermUpdated       = "glossary.term_updated"
+	MsgGlossaryTermDeleted       = "glossary.term_deleted"
+	MsgGlossaryImported          = "glossary.imported"
+	MsgAlive                     = "health.alive"
+	MsgReady                     = "health.ready"
+	MsgNotReady                  = "health.not_ready"
+	MsgVersionFetched            = "health.version"
+)
+
+// ErrorMessageID는 apperror 고정 코드에 해당하는 메세지 ID 를 반환합니다.
+func ErrorMessageID(code string) string {
+	return "error." + code
+}
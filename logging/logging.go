@@ -0,0 +1,114 @@
+// logging 패키지는 log/slog 기반의 구조화된 로그를 제공합니다.
+//
+// 요청 ID, 사용자 ID 처럼 요청마다 달라지는 값은 With 로 context 에 저장하며,
+// slog.InfoContext(ctx, ...) 처럼 context 를 전달하면 모든 로그에 함께 기록됩니다.
+// 비밀번호, 토큰 등 민감한 값은 키 이름으로 판단하여 기록하지 않습니다.
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/B-Bridger/server/config"
+)
+
+// Redacted는 민감한 값 대신 기록되는 값입니다.
+const Redacted = "[REDACTED]"
+
+// 값을 기록하지 않는 키 (소문자, '-', '_' 제외)
+var sensitiveKeys = map[string]struct{}{
+	"authorization":   {},
+	"cookie":          {},
+	"setcookie":       {},
+	"password":        {},
+	"newpassword":     {},
+	"currentpassword": {},
+	"secret":          {},
+	"apikey":          {},
+	"token":           {},
+	"accesstoken":     {},
+	"refreshtoken":    {},
+	"pushtoken":       {},
+	"fcmtoken":        {},
+}
+
+// IsSensitive는 키에 해당하는 값을 로그에 기록하면 안 되는지 반환합니다.
+// 대소문자, '-', '_' 는 구분하지 않습니다. (예: Authorization, refresh_token, X-Api-Key 의 api-key)
+func IsSensitive(key string) bool {
+	key = strings.ToLower(strings.NewReplacer("-", "", "_", "").Replace(key))
+	if _, ok := sensitiveKeys[key]; ok {
+		return true
+	}
+	return strings.HasSuffix(key, "apikey")
+}
+
+// New는 설정에 따라 w 에 기록하는 logger 를 생성합니다.
+// 설정 값은 config.Load 에서 검증하며, 알 수 없는 값은 기본값(info, json)을 사용합니다.
+func New(cfg config.Log, w io.Writer) *slog.Logger {
+	var level slog.Level
+	_ = level.UnmarshalText([]byte(cfg.Level))
+
+	opts := &slog.HandlerOptions{Level: level, ReplaceAttr: redact}
+	var handler slog.Handler
+	if cfg.Format == config.LogFormatText {
+		handler = slog.NewTextHandler(w, opts)
+	} else {
+		handler = slog.NewJSONHandler(w, opts)
+	}
+	return slog.New(&contextHandler{Handler: handler})
+}
+
+// redact는 민감한 키의 값을 Redacted 로 바꿉니다.
+func redact(groups []string, a slog.Attr) slog.Attr {
+	if IsSensitive(a.Key) {
+		return slog.String(a.Key, Redacted)
+	}
+	return a
+}
+
+// Headers는 HTTP 헤더를 민감한 값을 제외하고 로그에 기록할 수 있는 group 으로 변환합니다.
+func Headers(key string, h http.Header) slog.Attr {
+	attrs := make([]any, 0, len(h))
+	for name, values := range h {
+		value := strings.Join(values, ", ")
+		if IsSensitive(name) {
+			value = Redacted
+		}
+		attrs = append(attrs, slog.String(name, value))
+	}
+	return slog.Group(key, attrs...)
+}
+
+type contextKey struct{}
+
+// With는 ctx 로 기록하는 모든 로그에 attrs 를 추가하는 context 를 반환합니다.
+func With(ctx context.Context, attrs ...slog.Attr) context.Context {
+	prev, _ := ctx.Value(contextKey{}).([]slog.Attr)
+	merged := make([]slog.Attr, 0, len(prev)+len(attrs))
+	merged = append(merged, prev...)
+	merged = append(merged, attrs...)
+	return context.WithValue(ctx, contextKey{}, merged)
+}
+
+// contextHandler는 With 로 context 에 저장한 값을 로그에 추가합니다.
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h *contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if attrs, ok := ctx.Value(contextKey{}).([]slog.Attr); ok {
+		r.AddAttrs(attrs...)
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,73 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/B-Bridger/server/config"
+)
+
+func TestLoggerRedactsAndAddsContext(t *testing.T) {
+	var buf bytes.Buffer
+	logger := New(config.Log{Level: "info", Format: config.LogFormatJSON}, &buf)
+
+	ctx := With(context.Background(), slog.String("requestID", "req-1"))
+	ctx = With(ctx, slog.String("userID", "user-1"))
+
+	header := http.Header{}
+	header.Set("Authorization", "Bearer secret-token")
+	header.Set("Accept-Language", "ko")
+	logger.InfoContext(ctx, "login",
+		slog.String("password", "p@ssw0rd"),
+		slog.String("refresh_token", "refresh"),
+		slog.String("pushToken", "fcm"),
+		slog.String("email", "alice@example.com"),
+		Headers("headers", header),
+	)
+	logger.DebugContext(ctx, "debug 는 기록되지 않습니다")
+
+	var line map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &line); err != nil {
+		t.Fatalf("로그가 한 줄의 JSON 이 아닙니다: %v\n%s", err, buf.String())
+	}
+	for key, want := range map[string]string{
+		"requestID":     "req-1",
+		"userID":        "user-1",
+		"password":      Redacted,
+		"refresh_token": Redacted,
+		"pushToken":     Redacted,
+		"email":         "alice@example.com",
+	} {
+		if line[key] != want {
+			t.Errorf("%s = %v, want %q", key, line[key], want)
+		}
+	}
+	headers, _ := line["headers"].(map[string]any)
+	if headers["Authorization"] != Redacted || headers["Accept-Language"] != "ko" {
+		t.Errorf("headers = %v", headers)
+	}
+	if bytes.Contains(buf.Bytes(), []byte("secret-token")) || bytes.Contains(buf.Bytes(), []byte("p@ssw0rd")) {
+		t.Errorf("민감한 값이 기록되었습니다:\n%s", buf.String())
+	}
+}
+
+func TestIsSensitive(t *testing.T) {
+	for key, want := range map[string]bool{
+		"Authorization":  true,
+		"Set-Cookie":     true,
+		"newPassword":    true,
+		"X-Api-Key":      true,
+		"OPENAI_API_KEY": true,
+		"fcmToken":       true,
+		"userID":         false,
+		"tokenType":      false,
+	} {
+		if got := IsSensitive(key); got != want {
+			t.Errorf("IsSensitive(%q) = %v, want %v", key, got, want)
+		}
+	}
+}
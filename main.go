@@ -13,39 +13,218 @@ package main
 // @description JWT Authorization header using the Bearer scheme. Example: "Bearer {token}"
 
 import (
-	"log"
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
 	"os"
+	"os/signal"
+	"syscall"
 
+	"github.com/B-Bridger/server/config"
 	"github.com/B-Bridger/server/database"
+	"github.com/B-Bridger/server/database/migration"
 	_ "github.com/B-Bridger/server/docs"
 	"github.com/B-Bridger/server/handler"
-	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/hub"
+	"github.com/B-Bridger/server/logging"
+	"github.com/B-Bridger/server/metrics"
+	"github.com/B-Bridger/server/notification"
+	"github.com/B-Bridger/server/notification/fcm"
 	"github.com/B-Bridger/server/repository/mariaDB"
 	"github.com/B-Bridger/server/service"
+	"github.com/B-Bridger/server/tracing"
+	"github.com/B-Bridger/server/translation"
+	"github.com/B-Bridger/server/translation/local"
+	"github.com/B-Bridger/server/translation/openai"
+	"gorm.io/gorm"
 )
 
 func main() {
-	db, err := database.Connection()
+	cfg, err := config.Load()
 	if err != nil {
-		log.Fatal("DB 연결 실패:", err)
+		fatal("설정 불러오기 실패", err)
+	}
+	slog.SetDefault(logging.New(cfg.Log, os.Stdout))
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.Tracing)
+	if err != nil {
+		fatal("trace 설정 실패", err)
+	}
+
+	db, err := database.Connection(cfg.Database)
+	if err != nil {
+		fatal("DB 연결 실패", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		fatal("DB 연결 실패", err)
+	}
+
+	m := metrics.New()
+	if err := db.Use(m.GormPlugin()); err != nil {
+		fatal("DB 지표 등록 실패", err)
+	}
+	m.RegisterDBStats(sqlDB)
+	if err := db.Use(tracing.GormPlugin()); err != nil {
+		fatal("DB trace 등록 실패", err)
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		code := runMigrate(db, os.Args[2:])
+		sqlDB.Close()
+		os.Exit(code)
 	}
 
-	_ = db.AutoMigrate(&model.User{})
-	_ = db.AutoMigrate(&model.ChatRoom{})
+	migrator, err := migration.New(db, migration.All())
+	if err != nil {
+		fatal("마이그레이션 실패", err)
+	}
+	if err := migrateOnStart(migrator, cfg.Database.MigrateOnStart); err != nil {
+		fatal("마이그레이션 실패", err)
+	}
 
+	translator, err := newTranslator(cfg.Translation)
+	if err != nil {
+		fatal("번역기 초기화 실패", err)
+	}
+	// 번역 요청마다 지표와 span 을 기록하고, 번역 결과가 용어집을 지키는지 검증합니다.
+	translator = translation.EnforceGlossary(translation.Trace(translation.Instrument(translator, m)))
+
+	unitOfWork := &mariaDB.MariaDBUnitOfWork{DB: db}
 	userRepo := &mariaDB.MariaDBUserRepository{DB: db}
-	userService := &service.UserService{Repo: userRepo}
+	tokenRepo := &mariaDB.MariaDBTokenRepository{DB: db}
+	deviceRepo := &mariaDB.MariaDBDeviceRepository{DB: db}
+	userService := &service.UserService{Repo: userRepo, TokenRepo: tokenRepo, DeviceRepo: deviceRepo, UnitOfWork: unitOfWork, JWTSecret: cfg.Auth.Secret}
 	userHandler := &handler.UserHandler{Service: userService}
 	chatRoomRepo := &mariaDB.MariaDBChatRoomRepository{DB: db}
-	chatRoomService := &service.ChatRoomService{Repo: chatRoomRepo}
+	chatRoomMemberRepo := &mariaDB.MariaDBChatRoomMemberRepository{DB: db}
+	chatHub := hub.New()
+	m.RegisterWebSocketConnections(chatHub.Connections)
+	chatRoomService := &service.ChatRoomService{Repo: chatRoomRepo, MemberRepo: chatRoomMemberRepo, UserRepo: userRepo, UnitOfWork: unitOfWork, Connections: chatHub}
 	chatRoomHandler := &handler.ChatRoomHandler{Service: chatRoomService}
+	notifier, err := newNotifier(cfg.FCM)
+	if err != nil {
+		fatal("알림 발송기 초기화 실패", err)
+	}
+	notificationService := &service.NotificationService{Notifier: notifier, MemberRepo: chatRoomMemberRepo, DeviceRepo: deviceRepo, Presence: chatHub}
+	glossaryRepo := &mariaDB.MariaDBGlossaryRepository{DB: db}
+	glossaryService := &service.GlossaryService{Repo: glossaryRepo, UserRepo: userRepo, MemberRepo: chatRoomMemberRepo}
+	glossaryHandler := &handler.GlossaryHandler{Service: glossaryService}
+	messageRepo := &mariaDB.MariaDBMessageRepository{DB: db}
+	messageService := &service.MessageService{Repo: messageRepo, UserRepo: userRepo, ChatRoomRepo: chatRoomRepo, MemberRepo: chatRoomMemberRepo, GlossaryRepo: glossaryRepo, Translator: translator, Broadcaster: chatHub, Notifier: notificationService}
+	messageHandler := &handler.MessageHandler{Service: messageService}
+	webSocketHandler := &handler.WebSocketHandler{Hub: chatHub, UserService: userService, ChatRoomService: chatRoomService, MessageService: messageService, AllowedOrigins: cfg.Server.AllowedOrigins}
+
+	companyRepo := &mariaDB.MariaDBCompanyRepository{DB: db}
+	companyService := &service.CompanyService{Repo: companyRepo, UserRepo: userRepo, UnitOfWork: unitOfWork}
+	companyHandler := &handler.CompanyHandler{Service: companyService}
+
+	healthService := &service.HealthService{Checks: newHealthChecks(db, migrator, translator)}
+	healthHandler := &handler.HealthHandler{Service: healthService}
 
-	r := SetupRouter(userHandler, chatRoomHandler)
+	r := SetupRouter(cfg, m, userHandler, chatRoomHandler, messageHandler, webSocketHandler, companyHandler, glossaryHandler, healthHandler, tokenRepo)
 
-	port := os.Getenv("SERVER_PORT")
-	if port == "" {
-		port = "8080"
+	srv := newHTTPServer(cfg.Server, r)
+	ln, err := net.Listen("tcp", srv.Addr)
+	if err != nil {
+		fatal("서버 실행 실패", err)
+	}
+
+	// 첫 번째 종료 신호에서 graceful shutdown 을 시작하고, 두 번째 신호는 기본 동작(즉시 종료)을 따릅니다.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+
+	slog.Info("서버 실행 중", slog.String("addr", ln.Addr().String()))
+	err = serve(ctx, srv, ln, cfg.Server, healthService.Drain,
+		// HTTP 요청이 끝난 뒤 WebSocket 연결을 정리하고, 그동안 보낸 메세지의 알림까지 발송한 뒤 DB 연결을 닫습니다.
+		shutdownStep{name: "WebSocket 연결 종료", fn: chatHub.Shutdown},
+		shutdownStep{name: "알림 발송 대기", fn: notificationService.Wait},
+		shutdownStep{name: "DB 연결 종료", fn: func(context.Context) error { return sqlDB.Close() }},
+		shutdownStep{name: "trace 전송", fn: shutdownTracing},
+	)
+	if err != nil {
+		fatal("서버 종료 실패", err)
+	}
+	slog.Info("서버가 종료되었습니다.")
+}
+
+// fatal은 오류 로그를 남기고 프로세스를 종료합니다.
+func fatal(msg string, err error) {
+	slog.Error(msg, slog.Any("error", err))
+	os.Exit(1)
+}
+
+// newHealthChecks는 readiness 검사 목록을 생성합니다.
+// 마이그레이션 검사는 스키마를 변경하지 않는 조회만 실행하므로, 매 요청마다 실행해도 안전합니다.
+// 번역 제공자는 모든 인스턴스가 함께 의존하므로, 연결할 수 없어도 readiness 를 실패로 보지 않습니다.
+func newHealthChecks(db *gorm.DB, migrator *migration.Migrator, translator translation.Translator) []service.HealthCheck {
+	return []service.HealthCheck{
+		{Name: "database", Check: func(ctx context.Context) error {
+			sqlDB, err := db.DB()
+			if err != nil {
+				return err
+			}
+			return sqlDB.PingContext(ctx)
+		}},
+		{Name: "migration", Check: func(ctx context.Context) error {
+			pending, err := migrator.Pending(ctx)
+			if err != nil {
+				return err
+			}
+			if pending > 0 {
+				return fmt.Errorf("적용되지 않은 마이그레이션이 %d개 있습니다", pending)
+			}
+			return nil
+		}},
+		{Name: "translation", Optional: true, Check: func(ctx context.Context) error {
+			return translation.Ping(ctx, translator)
+		}},
+	}
+}
+
+// newTranslator는 설정의 Provider(openai, local)에 따라 번역기를 생성합니다.
+func newTranslator(cfg config.Translation) (translation.Translator, error) {
+	switch cfg.Provider {
+	case config.TranslationOpenAI:
+		return openai.New(openai.Config{
+			APIKey:     cfg.OpenAI.APIKey,
+			Model:      cfg.OpenAI.Model,
+			BaseURL:    cfg.OpenAI.BaseURL,
+			Timeout:    cfg.OpenAI.Timeout,
+			MaxRetries: cfg.OpenAI.MaxRetries,
+		}), nil
+	case config.TranslationLocal:
+		slog.Warn("local 번역기를 사용합니다. 실제 번역은 수행되지 않습니다.")
+		return local.New(), nil
+	default:
+		return nil, fmt.Errorf("지원하지 않는 TRANSLATION_PROVIDER 입니다: %s", cfg.Provider)
+	}
+}
+
+// newNotifier는 FCM 서비스 계정 키 파일이 설정되어 있으면 FCM 알림 발송기를 생성합니다.
+// 설정되어 있지 않으면 푸시 알림을 보내지 않습니다.
+func newNotifier(cfg config.FCM) (notification.Notifier, error) {
+	if cfg.CredentialsFile == "" {
+		slog.Warn("FCM 설정이 없어 푸시 알림을 보내지 않습니다.")
+		return nil, nil
+	}
+
+	credentials, err := os.ReadFile(cfg.CredentialsFile)
+	if err != nil {
+		return nil, fmt.Errorf("FCM 서비스 계정 키 파일을 읽을 수 없습니다: %v", err)
+	}
+
+	notifier, err := fcm.New(fcm.Config{
+		ProjectID:   cfg.ProjectID,
+		Credentials: credentials,
+		BaseURL:     cfg.BaseURL,
+		TokenURL:    cfg.TokenURL,
+	})
+	if err != nil {
+		return nil, err
 	}
-	log.Printf("서버 실행 중: http://localhost:%s", port)
-	r.Run(":" + port)
+	return notifier, nil
 }
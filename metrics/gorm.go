@@ -0,0 +1,72 @@
+package metrics
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// gorm Statement 에 쿼리 시작 시각을 저장하는 키
+const queryStartKey = "metrics:query_start"
+
+// gormPlugin은 GORM 쿼리 실행 시간을 기록하는 plugin 입니다.
+type gormPlugin struct {
+	m *Metrics
+}
+
+// GormPlugin은 쿼리 종류(create, query, update, delete, row, raw)와 테이블별로
+// 실행 시간과 실패 수를 기록하는 GORM plugin 을 반환합니다.
+//
+//	db.Use(m.GormPlugin())
+func (m *Metrics) GormPlugin() gorm.Plugin {
+	return &gormPlugin{m: m}
+}
+
+func (p *gormPlugin) Name() string {
+	return "metrics"
+}
+
+func (p *gormPlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	return errors.Join(
+		cb.Create().Before("gorm:create").Register("metrics:before_create", p.before),
+		cb.Create().After("gorm:create").Register("metrics:after_create", p.after("create")),
+		cb.Query().Before("gorm:query").Register("metrics:before_query", p.before),
+		cb.Query().After("gorm:query").Register("metrics:after_query", p.after("query")),
+		cb.Update().Before("gorm:update").Register("metrics:before_update", p.before),
+		cb.Update().After("gorm:update").Register("metrics:after_update", p.after("update")),
+		cb.Delete().Before("gorm:delete").Register("metrics:before_delete", p.before),
+		cb.Delete().After("gorm:delete").Register("metrics:after_delete", p.after("delete")),
+		cb.Row().Before("gorm:row").Register("metrics:before_row", p.before),
+		cb.Row().After("gorm:row").Register("metrics:after_row", p.after("row")),
+		cb.Raw().Before("gorm:raw").Register("metrics:before_raw", p.before),
+		cb.Raw().After("gorm:raw").Register("metrics:after_raw", p.after("raw")),
+	)
+}
+
+func (p *gormPlugin) before(db *gorm.DB) {
+	db.InstanceSet(queryStartKey, time.Now())
+}
+
+func (p *gormPlugin) after(operation string) func(db *gorm.DB) {
+	return func(db *gorm.DB) {
+		v, ok := db.InstanceGet(queryStartKey)
+		if !ok {
+			return
+		}
+		start, ok := v.(time.Time)
+		if !ok {
+			return
+		}
+
+		table := db.Statement.Table
+		if table == "" {
+			table = "unknown"
+		}
+		p.m.dbQueryDuration.WithLabelValues(operation, table).Observe(time.Since(start).Seconds())
+		if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+			p.m.dbQueryErrors.WithLabelValues(operation, table).Inc()
+		}
+	}
+}
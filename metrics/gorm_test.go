@@ -0,0 +1,51 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/B-Bridger/server/config"
+	"github.com/B-Bridger/server/database"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type metricsTestRecord struct {
+	ID   uint
+	Name string
+}
+
+func TestGormPlugin(t *testing.T) {
+	db, err := database.Connection(config.Database{Driver: config.DriverSQLite, Path: ":memory:"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := New()
+	if err := db.Use(m.GormPlugin()); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&metricsTestRecord{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.Create(&metricsTestRecord{Name: "a"}).Error; err != nil {
+		t.Fatal(err)
+	}
+	var record metricsTestRecord
+	if err := db.First(&record).Error; err != nil {
+		t.Fatal(err)
+	}
+	// 레코드 없음은 실패로 기록하지 않습니다.
+	db.First(&record, 100)
+	// 없는 테이블은 실패로 기록합니다.
+	db.Table("missing").Where("id = ?", 1).Delete(&metricsTestRecord{})
+
+	table := "metrics_test_records"
+	if n := testutil.CollectAndCount(m.dbQueryDuration, "bridger_db_query_duration_seconds"); n < 3 {
+		t.Fatalf("series = %d", n)
+	}
+	if got := testutil.ToFloat64(m.dbQueryErrors.WithLabelValues("query", table)); got != 0 {
+		t.Fatalf("query errors = %v, want 0", got)
+	}
+	if got := testutil.ToFloat64(m.dbQueryErrors.WithLabelValues("delete", "missing")); got != 1 {
+		t.Fatalf("delete errors = %v, want 1", got)
+	}
+}
@@ -0,0 +1,165 @@
+// metrics 패키지는 Prometheus 지표를 수집하고 /metrics 로 노출합니다.
+//
+// 지표는 프로세스마다 하나의 Metrics 에 등록하며, main 에서 생성하여
+// middleware(HTTP), GORM plugin(쿼리), 번역기(translation.Instrument)에 주입합니다.
+package metrics
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/B-Bridger/server/translation"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// 지표 이름의 접두사
+const namespace = "bridger"
+
+// 라우트가 없는 요청(404)의 route 라벨, 존재하지 않는 경로마다 라벨이 늘어나지 않도록 합니다.
+const unmatchedRoute = "unmatched"
+
+// 번역 지표의 언어 라벨로 그대로 기록하는 언어, 그 외의 언어는 otherLanguage 로 기록합니다.
+// 언어는 클라이언트가 보낸 값이므로 임의의 값마다 라벨이 늘어나지 않도록 합니다.
+var languageLabels = map[string]struct{}{"ko": {}, "en": {}, "ja": {}}
+
+const otherLanguage = "other"
+
+// Metrics는 서버의 Prometheus 지표입니다.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpRequests        *prometheus.CounterVec
+	httpDuration        *prometheus.HistogramVec
+	dbQueryDuration     *prometheus.HistogramVec
+	dbQueryErrors       *prometheus.CounterVec
+	translationRequests *prometheus.CounterVec
+	translationDuration *prometheus.HistogramVec
+	translationTokens   *prometheus.CounterVec
+}
+
+// New는 Go runtime, 프로세스 지표가 등록된 Metrics 를 생성합니다.
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace, Subsystem: "http", Name: "requests_total",
+			Help: "HTTP 요청 수 (route 는 /chat-room/:id 와 같은 라우트 템플릿)",
+		}, []string{"method", "route", "status"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace, Subsystem: "http", Name: "request_duration_seconds",
+			Help:    "HTTP 요청 처리 시간",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method", "route"}),
+		dbQueryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace, Subsystem: "db", Name: "query_duration_seconds",
+			Help:    "GORM 쿼리 실행 시간",
+			Buckets: []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+		}, []string{"operation", "table"}),
+		dbQueryErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace, Subsystem: "db", Name: "query_errors_total",
+			Help: "실패한 GORM 쿼리 수 (레코드 없음 제외)",
+		}, []string{"operation", "table"}),
+		translationRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace, Subsystem: "translation", Name: "requests_total",
+			Help: "번역 요청 수 (outcome 은 ok 또는 translation.ErrorKind)",
+		}, []string{"provider", "source_language", "target_language", "outcome"}),
+		translationDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace, Subsystem: "translation", Name: "request_duration_seconds",
+			Help:    "번역 요청 시간 (재시도 포함)",
+			Buckets: []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60},
+		}, []string{"provider", "source_language", "target_language"}),
+		translationTokens: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace, Subsystem: "translation", Name: "tokens_total",
+			Help: "번역 제공자가 보고한 토큰 사용량 (type 은 prompt, completion)",
+		}, []string{"provider", "type"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequests, m.httpDuration,
+		m.dbQueryDuration, m.dbQueryErrors,
+		m.translationRequests, m.translationDuration, m.translationTokens,
+	)
+	return m
+}
+
+// Handler는 지표를 Prometheus 형식으로 응답하는 http.Handler 를 반환합니다.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// RegisterDBStats는 DB 연결 풀 상태(열린 연결, 대기 시간 등)를 지표로 등록합니다.
+func (m *Metrics) RegisterDBStats(db *sql.DB) {
+	m.registry.MustRegister(collectors.NewDBStatsCollector(db, namespace))
+}
+
+// RegisterWebSocketConnections는 접속 중인 WebSocket 연결 수를 지표로 등록합니다.
+//
+// 매개 변수
+//   - count: 현재 연결 수를 반환하는 함수 (hub.Hub.Connections)
+func (m *Metrics) RegisterWebSocketConnections(count func() int) {
+	m.registry.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace, Subsystem: "websocket", Name: "connections",
+		Help: "접속 중인 WebSocket 연결 수",
+	}, func() float64 {
+		return float64(count())
+	}))
+}
+
+// ObserveHTTP는 HTTP 요청 하나를 기록합니다.
+//
+// 매개 변수
+//   - method: HTTP 메소드
+//   - route: 라우트 템플릿, 비어있으면 라우트가 없는 요청으로 기록합니다
+//   - status: 응답 상태 코드
+//   - elapsed: 처리 시간
+func (m *Metrics) ObserveHTTP(method, route string, status int, elapsed time.Duration) {
+	if route == "" {
+		route = unmatchedRoute
+	}
+	m.httpRequests.WithLabelValues(method, route, strconv.Itoa(status)).Inc()
+	m.httpDuration.WithLabelValues(method, route).Observe(elapsed.Seconds())
+}
+
+// ObserveTranslation은 translation.Observer 를 구현합니다.
+func (m *Metrics) ObserveTranslation(provider string, req *translation.Request, elapsed time.Duration, result *translation.Result, err error) {
+	source := "auto"
+	if req.SourceLanguage != "" {
+		source = languageLabel(req.SourceLanguage)
+	}
+	target := languageLabel(req.TargetLanguage)
+
+	outcome := "ok"
+	if err != nil {
+		outcome = "error"
+		var terr *translation.Error
+		if errors.As(err, &terr) {
+			outcome = string(terr.Kind)
+		}
+	}
+	m.translationRequests.WithLabelValues(provider, source, target, outcome).Inc()
+	m.translationDuration.WithLabelValues(provider, source, target).Observe(elapsed.Seconds())
+
+	if result != nil {
+		if result.PromptTokens > 0 {
+			m.translationTokens.WithLabelValues(provider, "prompt").Add(float64(result.PromptTokens))
+		}
+		if result.CompletionTokens > 0 {
+			m.translationTokens.WithLabelValues(provider, "completion").Add(float64(result.CompletionTokens))
+		}
+	}
+}
+
+// languageLabel은 언어를 지표 라벨 값으로 변환합니다. languageLabels 에 없는 언어는 otherLanguage 입니다.
+func languageLabel(language string) string {
+	if _, ok := languageLabels[language]; ok {
+		return language
+	}
+	return otherLanguage
+}
@@ -0,0 +1,40 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/B-Bridger/server/translation"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+// 지원하지 않는 언어는 other 라벨로 모아 기록해야 합니다.
+func TestObserveTranslationLanguageLabels(t *testing.T) {
+	m := New()
+	for _, req := range []*translation.Request{
+		{SourceLanguage: "ko", TargetLanguage: "en"},
+		{SourceLanguage: "fr", TargetLanguage: "en"},
+		{SourceLanguage: "xx-injected", TargetLanguage: "de"},
+		{TargetLanguage: "zz"},
+	} {
+		m.ObserveTranslation("local", req, time.Millisecond, nil, nil)
+	}
+
+	tests := []struct {
+		source, target string
+		want           float64
+	}{
+		{source: "ko", target: "en", want: 1},
+		{source: "other", target: "en", want: 1},
+		{source: "other", target: "other", want: 1},
+		{source: "auto", target: "other", want: 1},
+	}
+	for _, tt := range tests {
+		if got := testutil.ToFloat64(m.translationRequests.WithLabelValues("local", tt.source, tt.target, "ok")); got != tt.want {
+			t.Fatalf("%s→%s = %v, want %v", tt.source, tt.target, got, tt.want)
+		}
+	}
+	if n := testutil.CollectAndCount(m.translationRequests, "bridger_translation_requests_total"); n != len(tests) {
+		t.Fatalf("series = %d, want %d", n, len(tests))
+	}
+}
@@ -1,45 +1,139 @@
 package middleware
 
 import (
-	"net/http"
-	"os"
+	"context"
+	"errors"
+	"log/slog"
 	"strings"
 
+	"github.com/B-Bridger/server/apperror"
+	"github.com/B-Bridger/server/i18n"
+	"github.com/B-Bridger/server/logging"
 	"github.com/B-Bridger/server/model"
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 )
 
+var (
+	ErrTokenMissing   = apperror.New(apperror.KindUnauthorized, "token_missing", "토큰이 만료되었습니다")
+	ErrTokenMalformed = apperror.New(apperror.KindUnauthorized, "token_malformed", "토큰 형식이 올바르지 않습니다")
+	ErrTokenRevoked   = apperror.New(apperror.KindUnauthorized, "token_revoked", "토큰이 만료되었습니다")
+	ErrTokenInvalid   = apperror.New(apperror.KindForbidden, "token_invalid", "토큰이 유효하지 않습니다")
+)
+
+// TokenDenylist는 폐기된 access token 을 확인하는 역할을 추상화합니다.
+type TokenDenylist interface {
+	IsAccessTokenRevoked(ctx context.Context, jti string) (bool, error)
+}
+
 // 인증 middleware 구현
-// 인증 성공 시, context에 userID 키에 UserID 값을 저장
-func AuthMiddleware() gin.HandlerFunc {
+// 인증 성공 시, context에 userID 키에 UserID 값을, claims 키에 BridgerClaims 를 저장
+// 회사에 소속된 사용자는 companyID, companyRole 키에 소속 정보를 저장
+// 응답 메세지의 언어를 결정할 수 있도록 language 키에 사용자의 Language 를 저장
+// 이 요청의 로그에 기록되도록 요청 context 에 userID 를 추가
+// 토큰은 secret 으로 서명을 검증하며, denylist 에 있는 jti 를 가진 토큰은 거부합니다.
+func AuthMiddleware(secret string, denylist TokenDenylist) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		tokenString := c.GetHeader("Authorization")
 		if tokenString == "" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, model.ErrorResponse{Message: "토큰이 만료되었습니다", Detail: "token is empty", Status: 401})
+			abort(c, ErrTokenMissing)
 			return
 		}
 		splitToken := strings.SplitN(tokenString, "Bearer ", 2)
 		if len(splitToken) != 2 {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, model.ErrorResponse{Message: "토큰 형식이 올바르지 않습니다", Detail: "invalid token format", Status: 401})
+			abort(c, ErrTokenMalformed)
 			return
 		}
-		auth := strings.TrimSpace(splitToken[1])
 
-		token, err := jwt.ParseWithClaims(auth, &model.BridgerClaims{}, func(token *jwt.Token) (interface{}, error) {
-			return []byte(os.Getenv("SECRET")), nil
-		})
-		if err != nil {
-			c.AbortWithStatusJSON(http.StatusForbidden, model.ErrorResponse{Message: "접근 권한이 없습니다", Detail: err.Error(), Status: 403})
+		if !authenticate(c, secret, denylist, strings.TrimSpace(splitToken[1])) {
+			return
+		}
+		c.Next()
+	}
+}
+
+// WebSocket 인증 middleware 구현
+// 브라우저는 WebSocket 연결 시 헤더를 지정할 수 없으므로,
+// Authorization 헤더가 없으면 Sec-WebSocket-Protocol 헤더의 "bearer.<JWT>" subprotocol 로 전달된 토큰을 사용합니다.
+// token 쿼리 파라미터는 접근 로그, 프록시 기록에 토큰이 남으므로 이전 클라이언트 호환을 위해서만 허용합니다.
+func WebSocketAuthMiddleware(secret string, denylist TokenDenylist) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		auth := strings.TrimSpace(strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer "))
+		if auth == "" {
+			auth = protocolToken(c.Request.Header.Values("Sec-WebSocket-Protocol"))
+		}
+		if auth == "" {
+			auth = c.Query("token")
+		}
+		if auth == "" {
+			abort(c, ErrTokenMissing)
 			return
 		}
 
-		if claims, ok := token.Claims.(*model.BridgerClaims); ok && token.Valid {
-			c.Set("userID", claims.UserID)
-		} else {
-			c.AbortWithStatusJSON(http.StatusForbidden, model.ErrorResponse{Message: "토큰이 유효하지 않습니다", Detail: "invalid claims", Status: 403})
+		if !authenticate(c, secret, denylist, auth) {
 			return
 		}
 		c.Next()
 	}
 }
+
+// protocolToken은 Sec-WebSocket-Protocol 헤더 값에서 토큰 subprotocol 을 찾아 토큰을 반환합니다.
+// 토큰 subprotocol 이 없으면 빈 문자열을 반환합니다.
+func protocolToken(headers []string) string {
+	for _, header := range headers {
+		for _, protocol := range strings.Split(header, ",") {
+			if token, ok := strings.CutPrefix(strings.TrimSpace(protocol), model.WebSocketTokenProtocolPrefix); ok {
+				return token
+			}
+		}
+	}
+	return ""
+}
+
+// authenticate는 토큰을 검증하고 context에 userID를 저장합니다.
+// 검증에 실패하면 요청을 중단하고 false를 반환합니다.
+func authenticate(c *gin.Context, secret string, denylist TokenDenylist, auth string) bool {
+	token, err := jwt.ParseWithClaims(auth, &model.BridgerClaims{}, func(token *jwt.Token) (interface{}, error) {
+		return []byte(secret), nil
+	})
+	if err != nil {
+		abort(c, ErrTokenInvalid.WithDetail("%v", err))
+		return false
+	}
+
+	claims, ok := token.Claims.(*model.BridgerClaims)
+	if !ok || !token.Valid {
+		abort(c, ErrTokenInvalid.WithDetail("invalid claims"))
+		return false
+	}
+
+	if claims.ID != "" {
+		revoked, err := denylist.IsAccessTokenRevoked(c.Request.Context(), claims.ID)
+		if err != nil {
+			// 요청 취소 등 이미 분류된 오류는 그대로 응답합니다.
+			if !errors.As(err, new(*apperror.Error)) {
+				err = apperror.Unavailable(err)
+			}
+			abort(c, err)
+			return false
+		}
+		if revoked {
+			abort(c, ErrTokenRevoked)
+			return false
+		}
+	}
+
+	c.Set("userID", claims.UserID)
+	c.Set("companyID", claims.CompanyID)
+	c.Set("companyRole", claims.CompanyRole)
+	c.Set(i18n.LanguageKey, claims.Language)
+	c.Set("claims", claims)
+	c.Request = c.Request.WithContext(logging.With(c.Request.Context(), slog.String("userID", claims.UserID)))
+	return true
+}
+
+// abort는 요청을 중단하고 ErrorHandler 가 응답하도록 오류를 등록합니다.
+func abort(c *gin.Context, err error) {
+	c.Error(err)
+	c.Abort()
+}
@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/B-Bridger/server/apperror"
+	"github.com/B-Bridger/server/i18n"
+	"github.com/B-Bridger/server/model"
+	"github.com/gin-gonic/gin"
+)
+
+// 응답을 받기 전에 클라이언트가 요청을 취소한 경우의 상태 코드 (nginx 의 499 Client Closed Request)
+const statusClientClosedRequest = 499
+
+// 오류 분류별 HTTP 상태 코드
+var statusByKind = map[apperror.Kind]int{
+	apperror.KindNotFound:             http.StatusNotFound,
+	apperror.KindConflict:             http.StatusConflict,
+	apperror.KindForbidden:            http.StatusForbidden,
+	apperror.KindUnauthorized:         http.StatusUnauthorized,
+	apperror.KindValidation:           http.StatusBadRequest,
+	apperror.KindMethodNotAllowed:     http.StatusMethodNotAllowed,
+	apperror.KindUnsupportedMediaType: http.StatusUnsupportedMediaType,
+	apperror.KindUnavailable:          http.StatusServiceUnavailable,
+	apperror.KindInternal:             http.StatusInternalServerError,
+	apperror.KindCanceled:             statusClientClosedRequest,
+}
+
+// 오류 응답 middleware 구현
+// handler 와 middleware 는 c.Error(err) 로 오류를 등록하고 반환하며,
+// 응답이 작성되지 않은 경우 마지막 오류를 model.ErrorResponse 로 변환하여 응답합니다.
+// 메세지는 오류 코드에 해당하는 요청 언어의 메세지를 사용하며, 카탈로그에 없으면 오류의 Message 를 그대로 사용합니다.
+// 원인(apperror.Error.Err)은 release 모드가 아닐 때만 Detail 에 포함되며, 로그에는 Logger 가 원인과 함께 기록합니다.
+func ErrorHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+		writeError(c, c.Errors.Last().Err)
+	}
+}
+
+// writeError는 오류를 model.ErrorResponse 로 변환하여 응답합니다.
+func writeError(c *gin.Context, cause error) {
+	err := apperror.From(cause)
+	status, ok := statusByKind[err.Kind]
+	if !ok {
+		status = http.StatusInternalServerError
+	}
+
+	detail := err.Detail
+	if err.Err != nil && gin.Mode() != gin.ReleaseMode {
+		if detail != "" {
+			detail += ": "
+		}
+		detail += err.Err.Error()
+	}
+
+	message, ok := i18n.Lookup(i18n.Locale(c), i18n.ErrorMessageID(err.Code))
+	if !ok {
+		message = err.Message
+	}
+
+	c.JSON(status, model.ErrorResponse{Status: status, Code: err.Code, Message: message, Detail: detail})
+}
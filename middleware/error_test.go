@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/B-Bridger/server/apperror"
+	"github.com/B-Bridger/server/model"
+	"github.com/gin-gonic/gin"
+)
+
+func TestErrorHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Cleanup(func() { gin.SetMode(gin.DebugMode) })
+
+	notFound := apperror.New(apperror.KindNotFound, "thing_not_found", "찾을 수 없습니다")
+	tests := []struct {
+		name   string
+		mode   string
+		err    error
+		status int
+		want   model.ErrorResponse
+	}{
+		{
+			name:   "domain error",
+			mode:   gin.TestMode,
+			err:    notFound.WithDetail("id=1"),
+			status: http.StatusNotFound,
+			want:   model.ErrorResponse{Status: 404, Code: "thing_not_found", Message: "찾을 수 없습니다", Detail: "id=1"},
+		},
+		{
+			name:   "unknown error includes cause outside release mode",
+			mode:   gin.TestMode,
+			err:    errors.New("dial tcp: connection refused"),
+			status: http.StatusInternalServerError,
+			want:   model.ErrorResponse{Status: 500, Code: "internal_error", Message: "요청을 처리하는 중 오류가 발생하였습니다", Detail: "dial tcp: connection refused"},
+		},
+		{
+			name:   "release mode hides cause",
+			mode:   gin.ReleaseMode,
+			err:    apperror.Unavailable(errors.New("dial tcp: connection refused")),
+			status: http.StatusServiceUnavailable,
+			want:   model.ErrorResponse{Status: 503, Code: "service_unavailable", Message: "일시적으로 요청을 처리할 수 없습니다"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(tt.mode)
+			r := gin.New()
+			r.Use(ErrorHandler())
+			r.GET("/", func(c *gin.Context) {
+				c.Error(tt.err)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			if w.Code != tt.status {
+				t.Fatalf("status = %d, want %d", w.Code, tt.status)
+			}
+			var got model.ErrorResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Fatalf("response = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
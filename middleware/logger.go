@@ -0,0 +1,112 @@
+package middleware
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"runtime/debug"
+	"time"
+
+	"github.com/B-Bridger/server/apperror"
+	"github.com/B-Bridger/server/logging"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	// 요청 ID 를 주고받는 헤더
+	RequestIDHeader = "X-Request-ID"
+	// context 에 요청 ID 를 저장하는 키
+	RequestIDKey = "requestID"
+)
+
+// 클라이언트가 보낸 요청 ID 로 허용하는 형식, 로그에 임의의 문자열이 기록되지 않도록 제한합니다.
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// 상태 확인, 지표 수집 요청은 주기적으로 반복되므로 debug 레벨로 기록합니다.
+var quietRoutes = map[string]struct{}{
+	"/healthz": {},
+	"/readyz":  {},
+	"/metrics": {},
+}
+
+// 요청 ID middleware 구현
+// X-Request-ID 헤더가 올바른 형식이면 그대로 사용하고, 없으면 새로 생성합니다.
+// 요청 ID 는 응답 헤더와 context 의 requestID 키에 저장되며, 이 요청의 모든 로그에 기록됩니다.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIDHeader)
+		if !validRequestID.MatchString(id) {
+			id = uuid.NewString()
+		}
+
+		c.Set(RequestIDKey, id)
+		c.Header(RequestIDHeader, id)
+		c.Request = c.Request.WithContext(logging.With(c.Request.Context(), slog.String(RequestIDKey, id)))
+		c.Next()
+	}
+}
+
+// 요청 로그 middleware 구현
+// 요청이 끝나면 라우트, 상태 코드, 처리 시간 등을 한 줄로 기록합니다.
+// 쿼리 문자열은 토큰이 포함될 수 있으므로(WebSocket) 기록하지 않으며, 헤더는 debug 레벨에서 민감한 값을 제외하고 기록합니다.
+// 5xx 는 error, 4xx 는 warn, 그 외는 info 레벨입니다.
+func Logger() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		status := c.Writer.Status()
+		level := slog.LevelInfo
+		switch {
+		case status >= http.StatusInternalServerError:
+			level = slog.LevelError
+		case status >= http.StatusBadRequest:
+			level = slog.LevelWarn
+		default:
+			if _, ok := quietRoutes[c.FullPath()]; ok {
+				level = slog.LevelDebug
+			}
+		}
+
+		ctx := c.Request.Context()
+		if !slog.Default().Enabled(ctx, level) {
+			return
+		}
+
+		attrs := []slog.Attr{
+			slog.String("method", c.Request.Method),
+			slog.String("route", c.FullPath()),
+			slog.String("path", c.Request.URL.Path),
+			slog.Int("status", status),
+			slog.Duration("latency", time.Since(start)),
+			slog.String("clientIP", c.ClientIP()),
+			slog.String("userAgent", c.Request.UserAgent()),
+			slog.Int("bytes", c.Writer.Size()),
+		}
+		if len(c.Errors) > 0 {
+			attrs = append(attrs, slog.String("error", c.Errors.Last().Error()))
+		}
+		if slog.Default().Enabled(ctx, slog.LevelDebug) {
+			attrs = append(attrs, logging.Headers("headers", c.Request.Header))
+		}
+		slog.LogAttrs(ctx, level, "request", attrs...)
+	}
+}
+
+// 복구 middleware 구현
+// handler 에서 panic 이 발생하면 stack 과 함께 로그를 남기고 내부 오류로 응답합니다.
+func Recovery() gin.HandlerFunc {
+	return gin.CustomRecoveryWithWriter(io.Discard, func(c *gin.Context, recovered any) {
+		slog.ErrorContext(c.Request.Context(), "panic", slog.Any("panic", recovered), slog.String("stack", string(debug.Stack())))
+
+		err := apperror.Internal(fmt.Errorf("panic: %v", recovered))
+		c.Error(err)
+		c.Abort()
+		if !c.Writer.Written() {
+			writeError(c, err)
+		}
+	})
+}
@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/B-Bridger/server/config"
+	"github.com/B-Bridger/server/logging"
+	"github.com/gin-gonic/gin"
+)
+
+func TestRequestIDAndLogger(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var buf bytes.Buffer
+	prev := slog.Default()
+	slog.SetDefault(logging.New(config.Log{Level: "info", Format: config.LogFormatJSON}, &buf))
+	t.Cleanup(func() { slog.SetDefault(prev) })
+
+	r := gin.New()
+	r.Use(RequestID(), Logger(), Recovery(), ErrorHandler())
+	r.GET("/items/:id", func(c *gin.Context) {
+		c.String(http.StatusOK, c.GetString(RequestIDKey))
+	})
+	r.GET("/panic", func(c *gin.Context) {
+		panic("boom")
+	})
+
+	tests := []struct {
+		name     string
+		path     string
+		incoming string
+		status   int
+		keep     bool
+	}{
+		{name: "incoming", path: "/items/1", incoming: "abc-123", status: http.StatusOK, keep: true},
+		{name: "generated", path: "/items/2", status: http.StatusOK},
+		{name: "invalid incoming", path: "/items/3", incoming: "bad id\n", status: http.StatusOK},
+		{name: "panic", path: "/panic", status: http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf.Reset()
+			req := httptest.NewRequest(http.MethodGet, tt.path+"?token=secret", nil)
+			req.Header.Set("Authorization", "Bearer secret")
+			if tt.incoming != "" {
+				req.Header.Set(RequestIDHeader, tt.incoming)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if w.Code != tt.status {
+				t.Fatalf("status = %d, want %d", w.Code, tt.status)
+			}
+			id := w.Header().Get(RequestIDHeader)
+			if id == "" || (tt.keep && id != tt.incoming) || (!tt.keep && id == tt.incoming) {
+				t.Fatalf("request id = %q", id)
+			}
+			if bytes.Contains(buf.Bytes(), []byte("secret")) {
+				t.Fatalf("토큰이 로그에 기록되었습니다:\n%s", buf.String())
+			}
+
+			// 요청 로그는 마지막 줄입니다.
+			lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+			var line map[string]any
+			if err := json.Unmarshal(lines[len(lines)-1], &line); err != nil {
+				t.Fatal(err)
+			}
+			if line["requestID"] != id || line["status"] != float64(tt.status) {
+				t.Fatalf("log = %v", line)
+			}
+		})
+	}
+}
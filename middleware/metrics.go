@@ -0,0 +1,19 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/B-Bridger/server/metrics"
+	"github.com/gin-gonic/gin"
+)
+
+// Metrics는 요청마다 라우트 템플릿(c.FullPath, 예: /chat-room/:id)별 요청 수와 처리 시간을 기록합니다.
+// 경로의 ID 가 라벨에 들어가지 않으므로 지표 수가 요청 경로에 따라 늘어나지 않습니다.
+// WebSocket 요청은 연결이 끊길 때 한 번 기록됩니다.
+func Metrics(m *metrics.Metrics) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+		m.ObserveHTTP(c.Request.Method, c.FullPath(), c.Writer.Status(), time.Since(start))
+	}
+}
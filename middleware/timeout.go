@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 요청 처리 시간 제한 middleware 구현
+// 요청 context 에 d 만큼의 deadline 을 설정하여, 시간이 지나면 진행 중인 쿼리와 번역 요청이 취소되고
+// 요청 오류가 request_timeout 으로 응답됩니다. 클라이언트가 연결을 끊은 경우는 net/http 가 context 를 취소합니다.
+// 연결이 계속 유지되는 WebSocket 요청과 d 가 0 이하인 경우에는 제한하지 않습니다.
+func Timeout(d time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if d <= 0 || c.IsWebsocket() {
+			c.Next()
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), d)
+		defer cancel()
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/B-Bridger/server/model"
+	"github.com/gin-gonic/gin"
+)
+
+func TestTimeout(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	r := gin.New()
+	r.Use(ErrorHandler(), Timeout(10*time.Millisecond))
+	// 요청 context 가 끝날 때까지 기다리는 handler 로 쿼리 등 오래 걸리는 작업을 흉내냅니다.
+	r.GET("/slow", func(c *gin.Context) {
+		<-c.Request.Context().Done()
+		c.Error(c.Request.Context().Err())
+	})
+	r.GET("/ws", func(c *gin.Context) {
+		if _, ok := c.Request.Context().Deadline(); ok {
+			t.Error("WebSocket 요청에 deadline 이 설정되었습니다")
+		}
+		c.Status(http.StatusNoContent)
+	})
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	tests := []struct {
+		name   string
+		req    *http.Request
+		status int
+		code   string
+	}{
+		{name: "deadline", req: httptest.NewRequest(http.MethodGet, "/slow", nil), status: http.StatusServiceUnavailable, code: "request_timeout"},
+		{name: "client canceled", req: httptest.NewRequest(http.MethodGet, "/slow", nil).WithContext(canceled), status: statusClientClosedRequest, code: "request_canceled"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, tt.req)
+
+			var got model.ErrorResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+				t.Fatal(err)
+			}
+			if w.Code != tt.status || got.Code != tt.code {
+				t.Fatalf("status = %d, code = %q, want %d %q", w.Code, got.Code, tt.status, tt.code)
+			}
+		})
+	}
+
+	t.Run("websocket", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/ws", nil)
+		req.Header.Set("Connection", "Upgrade")
+		req.Header.Set("Upgrade", "websocket")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		if w.Code != http.StatusNoContent {
+			t.Fatalf("status = %d", w.Code)
+		}
+	})
+}
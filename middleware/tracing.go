@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/B-Bridger/server/logging"
+	"github.com/B-Bridger/server/tracing"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// 분산 추적 middleware 구현
+// 요청마다 라우트 템플릿(예: GET /chat-room/:id) 이름의 server span 을 시작하고,
+// 요청 헤더의 traceparent 가 있으면 상위 서비스의 trace 를 이어갑니다.
+// trace ID 는 이 요청의 모든 로그에 traceID 로 기록되며, 5xx 응답은 span 을 실패로 기록합니다.
+// RequestID 다음에 등록해야 span 에 요청 ID 가 기록됩니다.
+func Tracing() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := otel.GetTextMapPropagator().Extract(c.Request.Context(), propagation.HeaderCarrier(c.Request.Header))
+
+		route := c.FullPath()
+		name := c.Request.Method
+		if route != "" {
+			name += " " + route
+		}
+		ctx, span := tracing.Start(ctx, name,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(c.Request.Method),
+				semconv.HTTPRoute(route),
+				semconv.URLPath(c.Request.URL.Path),
+				semconv.ClientAddress(c.ClientIP()),
+				semconv.UserAgentOriginal(c.Request.UserAgent()),
+				attribute.String("request.id", c.GetString(RequestIDKey)),
+			),
+		)
+		defer span.End()
+
+		if sc := span.SpanContext(); sc.IsValid() {
+			ctx = logging.With(ctx, slog.String("traceID", sc.TraceID().String()))
+		}
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+
+		status := c.Writer.Status()
+		span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+		if status >= http.StatusInternalServerError {
+			if len(c.Errors) > 0 {
+				span.RecordError(c.Errors.Last().Err)
+			}
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracing(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	recorder := tracetest.NewSpanRecorder()
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	})
+
+	r := gin.New()
+	r.Use(RequestID(), Tracing(), ErrorHandler())
+	r.GET("/items/:id", func(c *gin.Context) {
+		if trace.SpanFromContext(c.Request.Context()).SpanContext().IsValid() {
+			c.Status(http.StatusNoContent)
+			return
+		}
+		c.Status(http.StatusOK)
+	})
+	r.GET("/fail", func(c *gin.Context) {
+		c.Error(errors.New("boom"))
+	})
+
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	req := httptest.NewRequest(http.MethodGet, "/items/1", nil)
+	req.Header.Set("traceparent", "00-"+traceID+"-00f067aa0ba902b7-01")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("handler 에서 span 을 찾을 수 없습니다: status = %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/fail", nil))
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("status = %d", w.Code)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("spans = %d, want 2", len(spans))
+	}
+	if got := spans[0]; got.Name() != "GET /items/:id" || got.SpanContext().TraceID().String() != traceID || got.SpanKind() != trace.SpanKindServer {
+		t.Fatalf("span = %s %s %s, 상위 trace 를 이어가야 합니다", got.Name(), got.SpanContext().TraceID(), got.SpanKind())
+	}
+	if got := spans[1]; got.Name() != "GET /fail" || got.Status().Code != codes.Error || got.Parent().IsValid() {
+		t.Fatalf("span = %s %v", got.Name(), got.Status())
+	}
+}
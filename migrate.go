@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/B-Bridger/server/database/migration"
+	"gorm.io/gorm"
+)
+
+const migrateUsage = `사용법: server migrate <command>
+
+  up          적용되지 않은 마이그레이션을 모두 적용합니다
+  down [n]    최근에 적용된 마이그레이션을 n개(기본 1개) 되돌립니다
+  status      마이그레이션 적용 상태를 출력합니다`
+
+// migrateOnStart는 서버 시작 시 마이그레이션을 처리합니다.
+// apply 가 false 이면(MIGRATE_ON_START=false) 적용하지 않고, 적용되지 않은 마이그레이션이 있을 때 시작을 거부합니다.
+func migrateOnStart(migrator *migration.Migrator, apply bool) error {
+	ctx := context.Background()
+	if !apply {
+		pending, err := migrator.Pending(ctx)
+		if err != nil {
+			return err
+		}
+		if pending > 0 {
+			return fmt.Errorf("적용되지 않은 마이그레이션이 %d개 있습니다. `server migrate up` 을 실행해주세요", pending)
+		}
+		return nil
+	}
+
+	applied, err := migrator.Up(ctx)
+	for _, m := range applied {
+		slog.Info("마이그레이션 적용", slog.Int64("version", m.Version), slog.String("name", m.Name))
+	}
+	return err
+}
+
+// runMigrate는 migrate 하위 명령을 실행하고 프로세스 종료 코드를 반환합니다.
+func runMigrate(db *gorm.DB, args []string) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		return 2
+	}
+
+	migrator, err := migration.New(db, migration.All())
+	if err != nil {
+		slog.Error("마이그레이션 실패", slog.Any("error", err))
+		return 1
+	}
+
+	ctx := context.Background()
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, m := range applied {
+			fmt.Printf("적용: %04d %s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			slog.Error("마이그레이션 실패", slog.Any("error", err))
+			return 1
+		}
+		if len(applied) == 0 {
+			fmt.Println("적용할 마이그레이션이 없습니다")
+		}
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps < 1 {
+				fmt.Fprintln(os.Stderr, migrateUsage)
+				return 2
+			}
+		}
+		reverted, err := migrator.Down(ctx, steps)
+		for _, m := range reverted {
+			fmt.Printf("되돌림: %04d %s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			slog.Error("마이그레이션 실패", slog.Any("error", err))
+			return 1
+		}
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			slog.Error("마이그레이션 실패", slog.Any("error", err))
+			return 1
+		}
+		printMigrationStatus(statuses)
+	default:
+		slog.Error("알 수 없는 명령입니다", slog.String("command", args[0]))
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		return 2
+	}
+
+	return 0
+}
+
+func printMigrationStatus(statuses []migration.Status) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+	for _, s := range statuses {
+		state, appliedAt := "pending", "-"
+		if s.Applied {
+			state = "applied"
+			appliedAt = s.AppliedAt.Format("2006-01-02 15:04:05")
+		}
+		fmt.Fprintf(w, "%04d\t%s\t%s\t%s\n", s.Version, s.Name, state, appliedAt)
+	}
+	w.Flush()
+}
@@ -0,0 +1,36 @@
+package model
+
+import "time"
+
+// WebSocket 으로 주고받는 이벤트 종류
+const (
+	ChatEventMessage = "message"
+	ChatEventJoin    = "join"
+	ChatEventLeave   = "leave"
+	ChatEventError   = "error"
+)
+
+// WebSocket 연결 시 Sec-WebSocket-Protocol 헤더로 지정하는 subprotocol
+// 브라우저는 헤더를 지정할 수 없으므로 "bridger, bearer.<JWT>" 처럼 access token 을 함께 전달하며,
+// 서버는 응답에 WebSocketProtocol 만 선택하여 토큰을 되돌려 보내지 않습니다.
+const (
+	WebSocketProtocol            = "bridger"
+	WebSocketTokenProtocolPrefix = "bearer."
+)
+
+// ChatEvent는 채팅방 WebSocket 으로 전달되는 이벤트입니다.
+type ChatEvent struct {
+	Type        string       `json:"type"`
+	ChatRoomID  string       `json:"chatRoomID"`
+	UserID      string       `json:"userID,omitempty"`
+	ChatMessage *MessageView `json:"chatMessage,omitempty"`
+	Detail      string       `json:"detail,omitempty"`
+	SentAt      time.Time    `json:"sentAt"`
+}
+
+// ChatCommand는 클라이언트가 WebSocket 으로 보내는 요청입니다.
+type ChatCommand struct {
+	Type     string `json:"type"`
+	Content  string `json:"content"`
+	Language string `json:"language"`
+}
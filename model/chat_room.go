@@ -9,9 +9,18 @@ import (
 
 // `ChatRoom` belongs to `User`, `UserID` is the foreign key
 type ChatRoom struct {
-	ChatRoomID    string    `gorm:"column:chatRoomID;primaryKey;" json:"chatRoomID"`
-	UserID        string    `gorm:"column:ownerUserID" json:"-"`
-	Owner         User      `gorm:"foreignKey:UserID;references:UserID" json:"owner"`
+	ChatRoomID string            `gorm:"column:chatRoomID;primaryKey;" json:"chatRoomID"`
+	UserID     string            `gorm:"column:ownerUserID" json:"-"`
+	Owner      User              `gorm:"foreignKey:UserID;references:UserID" json:"owner"`
+	Members    []ChatRoomMember  `gorm:"foreignKey:ChatRoomID;references:ChatRoomID" json:"members,omitempty"`
+	Companies  []ChatRoomCompany `gorm:"foreignKey:ChatRoomID;references:ChatRoomID" json:"companies,omitempty"`
+	// 채팅방 설정, 소유자와 관리자가 UpdateChatRoomModel 로 수정합니다.
+	Title       string `gorm:"column:title;size:100" json:"title"`
+	Description string `gorm:"column:description;size:500" json:"description"`
+	// 채팅방에서 주로 사용하는 언어 코드, 표시용이며 번역 대상 언어는 각 멤버의 Language 를 따릅니다.
+	DefaultLanguage string `gorm:"column:defaultLanguage;size:16" json:"defaultLanguage"`
+	Avatar          string `gorm:"column:avatar" json:"avatar"`
+	// 아래 필드는 서버가 관리하며 수정 요청으로 변경할 수 없습니다.
 	LastMessage   string    `gorm:"column:lastMessage" json:"lastMessage"`
 	LastMessageAt time.Time `gorm:"column:lastMessageAt" json:"lastMessageAt"`
 	CreatedAt     time.Time `gorm:"column:createdAt;autoCreateTime" json:"createdAt"`
@@ -21,6 +30,17 @@ type CreateChatRoomModel struct {
 	InviteUserIDS []string `json:"inviteUserIDs"`
 }
 
+// UpdateChatRoomModel은 채팅방 설정 수정(PATCH /chat-room/{id}) 요청의 JSON merge patch 문서입니다.
+// 없는 필드는 변경하지 않으며, null 은 값을 지웁니다.
+// 소유자, 멤버, 마지막 메세지, 생성 시각 등 여기에 없는 필드는 서버가 관리하므로 수정할 수 없습니다.
+type UpdateChatRoomModel struct {
+	Title           Optional[string] `json:"title" swaggertype:"string"`
+	Description     Optional[string] `json:"description" swaggertype:"string"`
+	DefaultLanguage Optional[string] `json:"defaultLanguage" swaggertype:"string"`
+	// 이미지 URL (http, https) 또는 업로드한 이미지 경로 (/static/uploads/...)
+	Avatar Optional[string] `json:"avatar" swaggertype:"string"`
+}
+
 func (cr *ChatRoom) BeforeCreate(tx *gorm.DB) (err error) {
 	if cr.ChatRoomID == "" {
 		cr.ChatRoomID = uuid.NewString()
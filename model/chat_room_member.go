@@ -0,0 +1,37 @@
+package model
+
+import "time"
+
+// 채팅방 멤버 역할
+const (
+	ChatRoomRoleOwner  = "owner"
+	ChatRoomRoleAdmin  = "admin"
+	ChatRoomRoleMember = "member"
+)
+
+// `ChatRoomMember` belongs to `ChatRoom` and `User`
+// 채팅방과 사용자의 N:M 관계를 나타내는 join table 입니다.
+type ChatRoomMember struct {
+	ChatRoomID string `gorm:"column:chatRoomID;primaryKey" json:"chatRoomID"`
+	UserID     string `gorm:"column:userID;primaryKey;index" json:"-"`
+	User       User   `gorm:"foreignKey:UserID;references:UserID" json:"user"`
+	Role       string `gorm:"column:role" json:"role"`
+	// true 이면 채팅방의 새 메세지 푸시 알림을 받지 않습니다.
+	Muted    bool      `gorm:"column:muted;default:false" json:"muted"`
+	JoinedAt time.Time `gorm:"column:joinedAt;autoCreateTime" json:"joinedAt"`
+}
+
+type ChatRoomMembersModel struct {
+	UserIDs []string `json:"userIDs"`
+	// 추가할 멤버의 역할 (admin, member), 비어있으면 member
+	Role string `json:"role"`
+}
+
+type ChatRoomMuteModel struct {
+	Muted bool `json:"muted"`
+}
+
+// CanManageMembers는 멤버를 초대하거나 내보낼 수 있는 역할인지 반환합니다.
+func (m *ChatRoomMember) CanManageMembers() bool {
+	return m.Role == ChatRoomRoleOwner || m.Role == ChatRoomRoleAdmin
+}
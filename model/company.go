@@ -0,0 +1,75 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// 회사 내 사용자 역할
+const (
+	CompanyRoleAdmin  = "admin"
+	CompanyRoleMember = "member"
+)
+
+// `Company` has many `User`
+type Company struct {
+	CompanyID string    `gorm:"column:companyID;primaryKey;" json:"companyID"`
+	Name      string    `gorm:"column:name" json:"name"`
+	Domain    string    `gorm:"column:domain" json:"domain"`
+	CreatedAt time.Time `gorm:"column:createdAt;autoCreateTime" json:"createdAt"`
+}
+
+// `ChatRoomCompany` belongs to `ChatRoom` and `Company`
+// 채팅방에 참여한 회사를 기록하여, 회사 간 대화인지 명시적으로 알 수 있게 합니다.
+type ChatRoomCompany struct {
+	ChatRoomID string    `gorm:"column:chatRoomID;primaryKey" json:"-"`
+	CompanyID  string    `gorm:"column:companyID;primaryKey;index" json:"-"`
+	Company    Company   `gorm:"foreignKey:CompanyID;references:CompanyID" json:"company"`
+	JoinedAt   time.Time `gorm:"column:joinedAt;autoCreateTime" json:"joinedAt"`
+}
+
+// `CompanyInvitation` belongs to `Company`
+// 회사 관리자가 이메일로 보낸 소속 초대입니다. 해당 이메일을 사용하는 사용자가 수락해야 회사에 소속됩니다.
+// 회사와 이메일마다 가장 최근 초대 하나만 유지합니다.
+type CompanyInvitation struct {
+	InvitationID string    `gorm:"column:invitationID;primaryKey;" json:"invitationID"`
+	CompanyID    string    `gorm:"column:companyID;uniqueIndex:idx_company_invitation" json:"companyID"`
+	Company      Company   `gorm:"foreignKey:CompanyID;references:CompanyID" json:"company"`
+	Email        string    `gorm:"column:email;uniqueIndex:idx_company_invitation;index" json:"email"`
+	Role         string    `gorm:"column:role" json:"role"`
+	InvitedBy    string    `gorm:"column:invitedBy" json:"invitedBy"`
+	ExpiresAt    time.Time `gorm:"column:expiresAt" json:"expiresAt"`
+	CreatedAt    time.Time `gorm:"column:createdAt;autoCreateTime" json:"createdAt"`
+}
+
+type CreateCompanyModel struct {
+	Name   string `json:"name"`
+	Domain string `json:"domain"`
+}
+
+type CompanyMemberModel struct {
+	Email string `json:"email"`
+	// 부여할 역할 (admin, member), 비어있으면 member
+	Role string `json:"role"`
+}
+
+type CompanyRoleModel struct {
+	// 변경할 역할 (admin, member)
+	Role string `json:"role"`
+}
+
+func (c *Company) BeforeCreate(tx *gorm.DB) (err error) {
+	if c.CompanyID == "" {
+		c.CompanyID = uuid.NewString()
+	}
+	return
+}
+
+func (i *CompanyInvitation) BeforeCreate(tx *gorm.DB) (err error) {
+	if i.InvitationID == "" {
+		i.InvitationID = uuid.NewString()
+	}
+	return
+}
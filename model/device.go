@@ -0,0 +1,46 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// 기기 플랫폼
+const (
+	DevicePlatformAndroid = "android"
+	DevicePlatformIOS     = "ios"
+	DevicePlatformWeb     = "web"
+)
+
+// `Device` belongs to `User`
+// 푸시 알림을 받을 사용자의 기기입니다. 한 사용자가 여러 기기를 등록할 수 있으며,
+// 기기를 등록한 로그인(session)이 로그아웃되거나 폐기되면 함께 삭제됩니다.
+type Device struct {
+	DeviceID string `gorm:"column:deviceID;primaryKey;" json:"deviceID"`
+	UserID   string `gorm:"column:userID;index" json:"-"`
+	// 기기를 등록한 로그인의 refresh token family ID (access token 의 sid)
+	SessionID  string    `gorm:"column:sessionID;size:64;index" json:"-"`
+	Platform   string    `gorm:"column:platform;size:16" json:"platform"`
+	PushToken  string    `gorm:"column:pushToken;size:255;uniqueIndex" json:"-"`
+	AppVersion string    `gorm:"column:appVersion;size:32" json:"appVersion"`
+	Locale     string    `gorm:"column:locale;size:16" json:"locale"`
+	LastSeenAt time.Time `gorm:"column:lastSeenAt;index" json:"lastSeenAt"`
+	CreatedAt  time.Time `gorm:"column:createdAt;autoCreateTime" json:"createdAt"`
+}
+
+type RegisterDeviceModel struct {
+	// android, ios, web
+	Platform   string `json:"platform"`
+	PushToken  string `json:"pushToken"`
+	AppVersion string `json:"appVersion"`
+	Locale     string `json:"locale"`
+}
+
+func (d *Device) BeforeCreate(tx *gorm.DB) (err error) {
+	if d.DeviceID == "" {
+		d.DeviceID = uuid.NewString()
+	}
+	return
+}
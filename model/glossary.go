@@ -0,0 +1,68 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// 용어집 적용 범위
+const (
+	GlossaryScopeCompany  = "company"
+	GlossaryScopeChatRoom = "chatRoom"
+)
+
+// `GlossaryTerm` has many `GlossaryTarget`
+// 회사 또는 채팅방 단위로 번역 시 반드시 지켜야 하는 용어입니다.
+// Scope 가 company 이면 ScopeID 는 CompanyID, chatRoom 이면 ChatRoomID 입니다.
+type GlossaryTerm struct {
+	TermID  string `gorm:"column:termID;primaryKey;" json:"termID"`
+	Scope   string `gorm:"column:scope;size:16;uniqueIndex:idx_glossary_terms_source,priority:1" json:"scope"`
+	ScopeID string `gorm:"column:scopeID;size:64;uniqueIndex:idx_glossary_terms_source,priority:2" json:"scopeID"`
+	Source  string `gorm:"column:source;size:255;uniqueIndex:idx_glossary_terms_source,priority:3" json:"source"`
+	// true 이면 대소문자가 정확히 일치할 때만 용어로 인식합니다.
+	CaseSensitive bool `gorm:"column:caseSensitive" json:"caseSensitive"`
+	// true 이면 번역하지 않고 원문 그대로 유지해야 합니다. (제품명, 부품 번호 등)
+	DoNotTranslate bool             `gorm:"column:doNotTranslate" json:"doNotTranslate"`
+	Targets        []GlossaryTarget `gorm:"foreignKey:TermID;references:TermID" json:"targets"`
+	CreatedAt      time.Time        `gorm:"column:createdAt;autoCreateTime" json:"createdAt"`
+	UpdatedAt      time.Time        `gorm:"column:updatedAt;autoUpdateTime" json:"updatedAt"`
+}
+
+// `GlossaryTarget` belongs to `GlossaryTerm`
+// 용어의 언어별 번역어입니다.
+type GlossaryTarget struct {
+	TermID   string `gorm:"column:termID;primaryKey" json:"-"`
+	Language string `gorm:"column:language;primaryKey;size:16" json:"language"`
+	Target   string `gorm:"column:target;size:255" json:"target"`
+}
+
+type GlossaryTermModel struct {
+	Source string `json:"source"`
+	// 언어별 번역어 (예: {"en": "purchase order", "ja": "発注書"})
+	Targets        map[string]string `json:"targets"`
+	CaseSensitive  bool              `json:"caseSensitive"`
+	DoNotTranslate bool              `json:"doNotTranslate"`
+}
+
+// TargetFor는 주어진 언어의 번역어를 반환합니다.
+// DoNotTranslate 용어는 항상 원문을 반환합니다.
+func (t *GlossaryTerm) TargetFor(language string) (string, bool) {
+	if t.DoNotTranslate {
+		return t.Source, true
+	}
+	for _, target := range t.Targets {
+		if target.Language == language && target.Target != "" {
+			return target.Target, true
+		}
+	}
+	return "", false
+}
+
+func (t *GlossaryTerm) BeforeCreate(tx *gorm.DB) (err error) {
+	if t.TermID == "" {
+		t.TermID = uuid.NewString()
+	}
+	return
+}
@@ -0,0 +1,33 @@
+package model
+
+// 상태 검사 결과
+const (
+	HealthStatusOK   = "ok"
+	HealthStatusFail = "fail"
+)
+
+// HealthCheckResult는 readiness 검사 하나의 결과입니다.
+type HealthCheckResult struct {
+	Name string `json:"name"`
+	// ok, fail
+	Status string `json:"status"`
+	// 검사에 걸린 시간 (밀리초)
+	LatencyMs int64 `json:"latencyMs"`
+	// 실패해도 readiness 에 영향을 주지 않는 검사
+	Optional bool `json:"optional,omitempty"`
+}
+
+type ReadinessResponse struct {
+	Status  int                 `json:"status"`
+	Message string              `json:"message"`
+	Ready   bool                `json:"ready"`
+	Checks  []HealthCheckResult `json:"checks"`
+}
+
+type VersionResponse struct {
+	Status    int    `json:"status"`
+	Message   string `json:"message"`
+	Commit    string `json:"commit"`
+	BuildTime string `json:"buildTime"`
+	GoVersion string `json:"goVersion"`
+}
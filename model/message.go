@@ -0,0 +1,101 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// `Message` belongs to `ChatRoom` and `User`(sender)
+// Content/Language 에는 사용자가 보낸 원문과 원문 언어가 저장됩니다.
+type Message struct {
+	MessageID    string               `gorm:"column:messageID;primaryKey;" json:"messageID"`
+	ChatRoomID   string               `gorm:"column:chatRoomID;index:idx_messages_chat_room_created,priority:1" json:"chatRoomID"`
+	UserID       string               `gorm:"column:senderUserID" json:"-"`
+	Sender       User                 `gorm:"foreignKey:UserID;references:UserID" json:"-"`
+	Content      string               `gorm:"column:content;type:text" json:"content"`
+	Language     string               `gorm:"column:language" json:"language"`
+	Translations []MessageTranslation `gorm:"foreignKey:MessageID;references:MessageID" json:"translations,omitempty"`
+	CreatedAt    time.Time            `gorm:"column:createdAt;autoCreateTime;index:idx_messages_chat_room_created,priority:2" json:"createdAt"`
+	UpdatedAt    time.Time            `gorm:"column:updatedAt;autoUpdateTime" json:"updatedAt"`
+}
+
+// `MessageTranslation` belongs to `Message`
+// 메세지 하나당 언어별로 한 번만 번역하여 저장합니다.
+type MessageTranslation struct {
+	MessageID string    `gorm:"column:messageID;primaryKey" json:"messageID"`
+	Language  string    `gorm:"column:language;primaryKey" json:"language"`
+	Content   string    `gorm:"column:content;type:text" json:"content"`
+	Provider  string    `gorm:"column:provider" json:"provider"`
+	CreatedAt time.Time `gorm:"column:createdAt;autoCreateTime" json:"createdAt"`
+}
+
+// MessageView는 수신자의 언어로 변환된 메세지입니다.
+// 번역본이 없으면 원문을 그대로 담고 Translated 는 false 입니다.
+type MessageView struct {
+	MessageID        string        `json:"messageID"`
+	ChatRoomID       string        `json:"chatRoomID"`
+	Sender           SenderSummary `json:"sender"`
+	Content          string        `json:"content"`
+	Language         string        `json:"language"`
+	OriginalLanguage string        `json:"originalLanguage"`
+	Translated       bool          `json:"translated"`
+	OriginalContent  string        `json:"originalContent,omitempty"`
+	CreatedAt        time.Time     `json:"createdAt"`
+}
+
+// SenderSummary는 메세지와 함께 채팅방 멤버에게 전달되는 보낸 사용자 정보입니다.
+// 이메일, 회사 소속 등 그 외의 사용자 정보는 포함하지 않습니다.
+type SenderSummary struct {
+	UserID  string `json:"userID"`
+	Name    string `json:"name"`
+	Profile string `json:"profile"`
+}
+
+type CreateMessageModel struct {
+	Content  string `json:"content"`
+	Language string `json:"language"`
+}
+
+func (m *Message) BeforeCreate(tx *gorm.DB) (err error) {
+	if m.MessageID == "" {
+		m.MessageID = uuid.NewString()
+	}
+
+	return
+}
+
+// Localize는 메세지를 주어진 언어의 MessageView 로 변환합니다.
+//
+// 매개 변수
+//   - language: 수신자의 언어
+//   - withOriginal: true 이면 OriginalContent 에 원문을 포함합니다
+func (m *Message) Localize(language string, withOriginal bool) MessageView {
+	view := MessageView{
+		MessageID:        m.MessageID,
+		ChatRoomID:       m.ChatRoomID,
+		Sender:           SenderSummary{UserID: m.UserID, Name: m.Sender.Name, Profile: m.Sender.Profile},
+		Content:          m.Content,
+		Language:         m.Language,
+		OriginalLanguage: m.Language,
+		CreatedAt:        m.CreatedAt,
+	}
+
+	if language != "" && language != m.Language {
+		for _, t := range m.Translations {
+			if t.Language == language {
+				view.Content = t.Content
+				view.Language = t.Language
+				view.Translated = true
+				break
+			}
+		}
+	}
+
+	if withOriginal {
+		view.OriginalContent = m.Content
+	}
+
+	return view
+}
@@ -0,0 +1,20 @@
+package model
+
+import "encoding/json"
+
+// Optional은 JSON merge patch(RFC 7396) 문서의 필드 값입니다.
+// 필드가 없으면 Set 이 false 이고(변경하지 않음), null 이면 Set, Null 이 모두 true 입니다(값 삭제).
+type Optional[T any] struct {
+	Set   bool
+	Null  bool
+	Value T
+}
+
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	o.Set = true
+	if string(data) == "null" {
+		o.Null = true
+		return nil
+	}
+	return json.Unmarshal(data, &o.Value)
+}
@@ -1,8 +1,11 @@
 // model/response.go
 package model
 
+// ErrorResponse는 실패한 요청의 응답입니다.
+// Code 는 apperror 의 고정 코드(예: user_not_found)로, 클라이언트는 Message 대신 Code 로 분기해야 합니다.
 type ErrorResponse struct {
 	Status  int    `json:"status"`
+	Code    string `json:"code"`
 	Message string `json:"message"`
 	Detail  string `json:"detail"`
 }
@@ -18,11 +21,43 @@ type UserResponse struct {
 	User    User   `json:"user"`
 }
 
-type TokenResponse struct {
+type UsersResponse struct {
 	Status  int    `json:"status"`
 	Message string `json:"message"`
-	User    User   `json:"user"`
-	Token   string `json:"token"`
+	Users   []User `json:"users"`
+}
+
+type CompanyResponse struct {
+	Status  int     `json:"status"`
+	Message string  `json:"message"`
+	Company Company `json:"company"`
+}
+
+type CompanyInvitationResponse struct {
+	Status     int               `json:"status"`
+	Message    string            `json:"message"`
+	Invitation CompanyInvitation `json:"invitation"`
+}
+
+type CompanyInvitationsResponse struct {
+	Status      int                 `json:"status"`
+	Message     string              `json:"message"`
+	Invitations []CompanyInvitation `json:"invitations"`
+}
+
+type TokenResponse struct {
+	Status       int    `json:"status"`
+	Message      string `json:"message"`
+	User         User   `json:"user"`
+	Token        string `json:"token"`
+	RefreshToken string `json:"refreshToken"`
+}
+
+type RefreshTokenResponse struct {
+	Status       int    `json:"status"`
+	Message      string `json:"message"`
+	Token        string `json:"token"`
+	RefreshToken string `json:"refreshToken"`
 }
 
 type ChatRoomResponse struct {
@@ -36,3 +71,52 @@ type ChatRoomsResponse struct {
 	Message   string     `json:"message"`
 	ChatRooms []ChatRoom `json:"chatRooms"`
 }
+
+type ChatRoomMembersResponse struct {
+	Status  int              `json:"status"`
+	Message string           `json:"message"`
+	Members []ChatRoomMember `json:"members"`
+}
+
+type MessageResponse struct {
+	Status      int         `json:"status"`
+	Message     string      `json:"message"`
+	ChatMessage MessageView `json:"chatMessage"`
+}
+
+type MessagesResponse struct {
+	Status     int           `json:"status"`
+	Message    string        `json:"message"`
+	Messages   []MessageView `json:"messages"`
+	NextCursor string        `json:"nextCursor"`
+}
+
+type GlossaryTermResponse struct {
+	Status  int          `json:"status"`
+	Message string       `json:"message"`
+	Term    GlossaryTerm `json:"term"`
+}
+
+type GlossaryTermsResponse struct {
+	Status  int            `json:"status"`
+	Message string         `json:"message"`
+	Terms   []GlossaryTerm `json:"terms"`
+}
+
+type GlossaryImportResponse struct {
+	Status   int    `json:"status"`
+	Message  string `json:"message"`
+	Imported int    `json:"imported"`
+}
+
+type DeviceResponse struct {
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+	Device  Device `json:"device"`
+}
+
+type DevicesResponse struct {
+	Status  int      `json:"status"`
+	Message string   `json:"message"`
+	Devices []Device `json:"devices"`
+}
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// `RefreshToken` belongs to `User`
+// 토큰 원문은 저장하지 않고 SHA-256 해시만 저장합니다.
+// 같은 로그인에서 재발급된 토큰들은 같은 FamilyID 를 가집니다.
+type RefreshToken struct {
+	TokenID   string     `gorm:"column:tokenID;primaryKey;" json:"-"`
+	UserID    string     `gorm:"column:userID;index" json:"-"`
+	FamilyID  string     `gorm:"column:familyID;index" json:"-"`
+	TokenHash string     `gorm:"column:tokenHash;size:64;uniqueIndex" json:"-"`
+	ExpiresAt time.Time  `gorm:"column:expiresAt" json:"-"`
+	RevokedAt *time.Time `gorm:"column:revokedAt" json:"-"`
+	CreatedAt time.Time  `gorm:"column:createdAt;autoCreateTime" json:"-"`
+}
+
+// `RevokedToken` 은 만료 전에 폐기된 access token 의 jti 목록(denylist)입니다.
+type RevokedToken struct {
+	JTI       string    `gorm:"column:jti;primaryKey;size:64" json:"-"`
+	ExpiresAt time.Time `gorm:"column:expiresAt;index" json:"-"`
+	CreatedAt time.Time `gorm:"column:createdAt;autoCreateTime" json:"-"`
+}
+
+// TokenPair는 로그인, 토큰 재발급 시 발급되는 토큰 묶음입니다.
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+func (t *RefreshToken) BeforeCreate(tx *gorm.DB) (err error) {
+	if t.TokenID == "" {
+		t.TokenID = uuid.NewString()
+	}
+	return
+}
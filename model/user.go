@@ -13,12 +13,12 @@ type User struct {
 	Password string `gorm:"column:password" json:"-"`
 	Name     string `gorm:"column:name" json:"name"`
 	Email    string `gorm:"column:email;unique" json:"email"`
-	// TODO: Company 테이블 여부 회의 필요
-	// CompanyID string    `gorm:"column:companyId" json:"companyId"`
-	Language  string    `gorm:"column:language" json:"language"`
-	Profile   string    `gorm:"column:profile" json:"profile"`
-	CreatedAt time.Time `gorm:"column:createdAt;autoCreateTime" json:"createdAt"`
-	FcmToken  string    `gorm:"column:fcmToken" json:"fcmToken"`
+	// 소속 회사가 없으면 빈 문자열
+	CompanyID   string    `gorm:"column:companyID;index" json:"companyID"`
+	CompanyRole string    `gorm:"column:companyRole" json:"companyRole"`
+	Language    string    `gorm:"column:language" json:"language"`
+	Profile     string    `gorm:"column:profile" json:"profile"`
+	CreatedAt   time.Time `gorm:"column:createdAt;autoCreateTime" json:"createdAt"`
 }
 
 type CreateUserModel struct {
@@ -28,8 +28,56 @@ type CreateUserModel struct {
 	Language string `gorm:"column:language" json:"language"`
 }
 
+// UpdateUserModel은 사용자 정보 수정(PATCH /users) 요청의 JSON merge patch 문서입니다.
+// 없는 필드는 변경하지 않으며, null 은 값을 지웁니다. (name 은 지울 수 없습니다)
+// 이메일, 비밀번호, 회사 소속 등 여기에 없는 필드는 수정할 수 없으며, 이메일과 비밀번호는 별도의 요청으로 변경합니다.
+type UpdateUserModel struct {
+	Name     Optional[string] `json:"name" swaggertype:"string"`
+	Language Optional[string] `json:"language" swaggertype:"string"`
+	// 프로필 이미지 삭제(null)만 가능하며, 변경은 POST /users/profile-image 로 업로드합니다.
+	Profile Optional[string] `json:"profile" swaggertype:"string"`
+}
+
+// ChangeEmailModel은 이메일 변경 요청입니다.
+type ChangeEmailModel struct {
+	Email string `json:"email"`
+	// 현재 비밀번호
+	Password string `json:"password"`
+}
+
+// VerifyEmailModel은 새 이메일로 발송된 확인 토큰입니다.
+type VerifyEmailModel struct {
+	Token string `json:"token"`
+}
+
+// ChangePasswordModel은 비밀번호 변경 요청입니다.
+type ChangePasswordModel struct {
+	CurrentPassword string `json:"currentPassword"`
+	NewPassword     string `json:"newPassword"`
+}
+
+// `EmailChange` belongs to `User`
+// 확인을 기다리는 이메일 변경 요청입니다. 새 이메일로 발송한 토큰을 확인해야 사용자 이메일이 바뀝니다.
+// 토큰 원문은 저장하지 않고 SHA-256 해시만 저장하며, 사용자당 가장 최근 요청 하나만 유지합니다.
+type EmailChange struct {
+	ChangeID  string    `gorm:"column:changeID;primaryKey;" json:"-"`
+	UserID    string    `gorm:"column:userID;index" json:"-"`
+	Email     string    `gorm:"column:email" json:"-"`
+	TokenHash string    `gorm:"column:tokenHash;size:64;uniqueIndex" json:"-"`
+	ExpiresAt time.Time `gorm:"column:expiresAt" json:"-"`
+	CreatedAt time.Time `gorm:"column:createdAt;autoCreateTime" json:"-"`
+}
+
+// BridgerClaims는 access token 의 claim 입니다.
+// RegisteredClaims.ID(jti)는 토큰 폐기에, SessionID 는 refresh token family 식별에 사용합니다.
+// CompanyID, CompanyRole 은 발급 시점의 소속 정보이며 tenant 단위 권한 확인에 사용합니다.
+// Language 는 발급 시점의 사용자 Language 이며 응답 메세지의 언어를 결정하는 데 사용합니다.
 type BridgerClaims struct {
-	UserID string `json:"userID"`
+	UserID      string `json:"userID"`
+	CompanyID   string `json:"companyID,omitempty"`
+	CompanyRole string `json:"companyRole,omitempty"`
+	Language    string `json:"language,omitempty"`
+	SessionID   string `json:"sid"`
 	jwt.RegisteredClaims
 }
 
@@ -39,3 +87,10 @@ func (u *User) BeforeCreate(tx *gorm.DB) (err error) {
 	}
 	return
 }
+
+func (c *EmailChange) BeforeCreate(tx *gorm.DB) (err error) {
+	if c.ChangeID == "" {
+		c.ChangeID = uuid.NewString()
+	}
+	return
+}
@@ -0,0 +1,321 @@
+// fcm 패키지는 Firebase Cloud Messaging HTTP v1 API 로 푸시 알림을 발송하는 Notifier 를 제공합니다.
+//
+// 인증은 서비스 계정 키(JSON)로 OAuth 2.0 access token 을 발급받아 사용하며,
+// BaseURL, TokenURL 을 바꾸면 로컬 대체 서버로도 동작을 확인할 수 있습니다.
+package fcm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/B-Bridger/server/notification"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	providerName = "fcm"
+
+	DefaultBaseURL     = "https://fcm.googleapis.com"
+	DefaultTokenURL    = "https://oauth2.googleapis.com/token"
+	DefaultConcurrency = 10
+	DefaultTimeout     = 10 * time.Second
+
+	messagingScope = "https://www.googleapis.com/auth/firebase.messaging"
+	// access token 만료 전에 미리 갱신하는 여유 시간
+	tokenRefreshMargin = time.Minute
+)
+
+// Config는 FCM Notifier 설정입니다.
+type Config struct {
+	// Firebase 프로젝트 ID, 비어있으면 서비스 계정 키의 project_id 를 사용합니다.
+	ProjectID string
+	// 서비스 계정 키 JSON
+	Credentials []byte
+	BaseURL     string
+	// 비어있으면 서비스 계정 키의 token_uri 를, 그것도 없으면 DefaultTokenURL 을 사용합니다.
+	TokenURL string
+	// 동시에 발송하는 최대 요청 수
+	Concurrency int
+	// 요청 1회에 허용되는 시간
+	Timeout time.Duration
+	// 지정하지 않으면 http.DefaultClient 를 사용합니다.
+	HTTPClient *http.Client
+}
+
+type serviceAccount struct {
+	ProjectID    string `json:"project_id"`
+	PrivateKeyID string `json:"private_key_id"`
+	PrivateKey   string `json:"private_key"`
+	ClientEmail  string `json:"client_email"`
+	TokenURI     string `json:"token_uri"`
+}
+
+// Notifier는 FCM HTTP v1 API 로 알림을 발송합니다.
+// HTTP v1 API 는 일괄 발송을 지원하지 않으므로, 알림마다 요청을 보내되 Concurrency 만큼 동시에 진행합니다.
+type Notifier struct {
+	cfg     Config
+	account serviceAccount
+	signer  interface{}
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+// New는 주어진 설정으로 Notifier 를 생성합니다. 비어있는 값은 기본값으로 채웁니다.
+func New(cfg Config) (*Notifier, error) {
+	var account serviceAccount
+	if err := json.Unmarshal(cfg.Credentials, &account); err != nil {
+		return nil, fmt.Errorf("FCM 서비스 계정 키 형식이 올바르지 않습니다: %v", err)
+	}
+	if account.ClientEmail == "" || account.PrivateKey == "" {
+		return nil, errors.New("FCM 서비스 계정 키에 client_email 또는 private_key 가 없습니다")
+	}
+	key, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(account.PrivateKey))
+	if err != nil {
+		return nil, fmt.Errorf("FCM 서비스 계정 키의 private_key 를 읽을 수 없습니다: %v", err)
+	}
+
+	if cfg.ProjectID == "" {
+		cfg.ProjectID = account.ProjectID
+	}
+	if cfg.ProjectID == "" {
+		return nil, errors.New("FCM 프로젝트 ID 가 없습니다")
+	}
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = DefaultBaseURL
+	}
+	cfg.BaseURL = strings.TrimRight(cfg.BaseURL, "/")
+	if cfg.TokenURL == "" {
+		cfg.TokenURL = account.TokenURI
+	}
+	if cfg.TokenURL == "" {
+		cfg.TokenURL = DefaultTokenURL
+	}
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = DefaultConcurrency
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = http.DefaultClient
+	}
+
+	return &Notifier{cfg: cfg, account: account, signer: key}, nil
+}
+
+func (n *Notifier) Name() string {
+	return providerName
+}
+
+// Send는 알림을 Concurrency 만큼 동시에 발송합니다.
+// access token 을 발급받지 못하면 모든 알림이 같은 error 로 실패합니다.
+func (n *Notifier) Send(ctx context.Context, notifications []*notification.Notification) []error {
+	errs := make([]error, len(notifications))
+	if len(notifications) == 0 {
+		return errs
+	}
+
+	accessToken, err := n.token(ctx)
+	if err != nil {
+		for i := range errs {
+			errs[i] = err
+		}
+		return errs
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, n.cfg.Concurrency)
+	for i, message := range notifications {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, message *notification.Notification) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = n.send(ctx, accessToken, message)
+		}(i, message)
+	}
+	wg.Wait()
+
+	return errs
+}
+
+type sendRequest struct {
+	Message message `json:"message"`
+}
+
+type message struct {
+	Token        string            `json:"token"`
+	Notification *messageBody      `json:"notification,omitempty"`
+	Data         map[string]string `json:"data,omitempty"`
+}
+
+type messageBody struct {
+	Title string `json:"title,omitempty"`
+	Body  string `json:"body,omitempty"`
+}
+
+type errorResponse struct {
+	Error struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Status  string `json:"status"`
+		Details []struct {
+			Type      string `json:"@type"`
+			ErrorCode string `json:"errorCode"`
+			// google.rpc.BadRequest 의 잘못된 필드 목록
+			FieldViolations []struct {
+				Field       string `json:"field"`
+				Description string `json:"description"`
+			} `json:"fieldViolations"`
+		} `json:"details"`
+	} `json:"error"`
+}
+
+// send는 알림 하나를 발송합니다.
+func (n *Notifier) send(ctx context.Context, accessToken string, m *notification.Notification) error {
+	body, err := json.Marshal(&sendRequest{Message: message{
+		Token:        m.Token,
+		Notification: &messageBody{Title: m.Title, Body: m.Body},
+		Data:         m.Data,
+	}})
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, n.cfg.Timeout)
+	defer cancel()
+
+	endpoint := fmt.Sprintf("%s/v1/projects/%s/messages:send", n.cfg.BaseURL, url.PathEscape(n.cfg.ProjectID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	resp, err := n.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("fcm: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+
+	respBody, _ := io.ReadAll(resp.Body)
+	return parseError(resp.StatusCode, respBody)
+}
+
+// parseError는 FCM 오류 응답을 error 로 변환합니다.
+// UNREGISTERED(등록 해제된 토큰)와 기기 토큰이 잘못되었다는 INVALID_ARGUMENT 는 notification.ErrInvalidToken 으로 감쌉니다.
+// INVALID_ARGUMENT 는 너무 큰 payload, 잘못된 data 키 등 요청 자체의 오류에도 사용되므로,
+// 이 경우에는 정상 기기가 삭제되지 않도록 일반 error 로 반환합니다.
+func parseError(statusCode int, body []byte) error {
+	var parsed errorResponse
+	_ = json.Unmarshal(body, &parsed)
+
+	code := parsed.Error.Status
+	for _, detail := range parsed.Error.Details {
+		if detail.ErrorCode != "" {
+			code = detail.ErrorCode
+			break
+		}
+	}
+
+	err := fmt.Errorf("fcm: status %d %s: %s", statusCode, code, parsed.Error.Message)
+	if code == "UNREGISTERED" || (code == "INVALID_ARGUMENT" && invalidTokenArgument(&parsed)) {
+		return fmt.Errorf("%w: %v", notification.ErrInvalidToken, err)
+	}
+	return err
+}
+
+// invalidTokenArgument는 INVALID_ARGUMENT 응답이 기기 토큰(message.token)을 가리키는지 반환합니다.
+func invalidTokenArgument(parsed *errorResponse) bool {
+	for _, detail := range parsed.Error.Details {
+		for _, violation := range detail.FieldViolations {
+			if violation.Field == "message.token" {
+				return true
+			}
+		}
+	}
+	// FieldViolations 가 없는 응답은 메세지로 확인합니다. (예: "The registration token is not a valid FCM registration token")
+	return strings.Contains(strings.ToLower(parsed.Error.Message), "registration token")
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+	TokenType   string `json:"token_type"`
+}
+
+// token은 캐시된 access token 을 반환하고, 만료가 가까우면 새로 발급받습니다.
+func (n *Notifier) token(ctx context.Context) (string, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.accessToken != "" && time.Until(n.expiresAt) > tokenRefreshMargin {
+		return n.accessToken, nil
+	}
+
+	now := time.Now()
+	assertion := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":   n.account.ClientEmail,
+		"scope": messagingScope,
+		"aud":   n.cfg.TokenURL,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	})
+	if n.account.PrivateKeyID != "" {
+		assertion.Header["kid"] = n.account.PrivateKeyID
+	}
+	signed, err := assertion.SignedString(n.signer)
+	if err != nil {
+		return "", fmt.Errorf("fcm: access token 요청 서명 실패: %w", err)
+	}
+
+	form := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {signed},
+	}
+	ctx, cancel := context.WithTimeout(ctx, n.cfg.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.cfg.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := n.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("fcm: access token 발급 실패: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("fcm: access token 발급 실패: status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var parsed tokenResponse
+	if err := json.Unmarshal(body, &parsed); err != nil || parsed.AccessToken == "" {
+		return "", fmt.Errorf("fcm: access token 응답 형식이 올바르지 않습니다")
+	}
+
+	n.accessToken = parsed.AccessToken
+	n.expiresAt = now.Add(time.Duration(parsed.ExpiresIn) * time.Second)
+	return n.accessToken, nil
+}
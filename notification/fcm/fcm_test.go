@@ -0,0 +1,335 @@
+package fcm_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/notification"
+	"github.com/B-Bridger/server/notification/fcm"
+	"github.com/B-Bridger/server/repository/memory"
+	"github.com/B-Bridger/server/service"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const testProjectID = "test-project"
+
+// fakeFCM은 OAuth token 발급과 FCM HTTP v1 발송 API 를 흉내 내는 로컬 대체 서버입니다.
+type fakeFCM struct {
+	t   *testing.T
+	key *rsa.PrivateKey
+	// 발급하는 access token 의 유효 기간 (초)
+	expiresIn int
+	// 발송 요청마다 응답 전에 기다리는 시간
+	delay time.Duration
+	// 기기 토큰별 오류 응답 (상태 코드, JSON 본문), 없으면 성공합니다.
+	failures  map[string]failure
+	tokenFail bool
+
+	mu            sync.Mutex
+	tokenRequests int
+	sent          []string
+	inFlight      int
+	maxInFlight   int
+}
+
+type failure struct {
+	status int
+	body   string
+}
+
+func newFakeFCM(t *testing.T) (*fakeFCM, *httptest.Server) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &fakeFCM{t: t, key: key, expiresIn: 3600, failures: map[string]failure{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /token", f.issueToken)
+	mux.HandleFunc("POST /v1/projects/"+testProjectID+"/messages:send", f.send)
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return f, srv
+}
+
+// notifier는 f 를 사용하는 Notifier 를 생성합니다.
+func (f *fakeFCM) notifier(srv *httptest.Server, concurrency int) *fcm.Notifier {
+	f.t.Helper()
+
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(f.key)})
+	credentials, err := json.Marshal(map[string]string{
+		"project_id":   testProjectID,
+		"private_key":  string(keyPEM),
+		"client_email": "bridger@test-project.iam.gserviceaccount.com",
+		"token_uri":    srv.URL + "/token",
+	})
+	if err != nil {
+		f.t.Fatal(err)
+	}
+	n, err := fcm.New(fcm.Config{Credentials: credentials, BaseURL: srv.URL, Concurrency: concurrency, Timeout: 5 * time.Second})
+	if err != nil {
+		f.t.Fatal(err)
+	}
+	return n
+}
+
+func (f *fakeFCM) issueToken(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	f.tokenRequests++
+	count := f.tokenRequests
+	f.mu.Unlock()
+
+	if f.tokenFail {
+		http.Error(w, `{"error":"invalid_grant"}`, http.StatusUnauthorized)
+		return
+	}
+	if r.FormValue("grant_type") != "urn:ietf:params:oauth:grant-type:jwt-bearer" {
+		http.Error(w, "grant_type", http.StatusBadRequest)
+		return
+	}
+	// 서비스 계정 키로 서명한 assertion 인지 확인합니다.
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(r.FormValue("assertion"), claims, func(*jwt.Token) (any, error) { return &f.key.PublicKey, nil }); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if claims["scope"] != "https://www.googleapis.com/auth/firebase.messaging" {
+		http.Error(w, "scope", http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprintf(w, `{"access_token":"access-%d","expires_in":%d,"token_type":"Bearer"}`, count, f.expiresIn)
+}
+
+func (f *fakeFCM) send(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer access-") {
+		http.Error(w, "unauthenticated", http.StatusUnauthorized)
+		return
+	}
+	var req struct {
+		Message struct {
+			Token string `json:"token"`
+		} `json:"message"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	f.mu.Lock()
+	f.sent = append(f.sent, req.Message.Token)
+	f.inFlight++
+	f.maxInFlight = max(f.maxInFlight, f.inFlight)
+	f.mu.Unlock()
+	defer func() {
+		f.mu.Lock()
+		f.inFlight--
+		f.mu.Unlock()
+	}()
+
+	time.Sleep(f.delay)
+	if fail, ok := f.failures[req.Message.Token]; ok {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(fail.status)
+		fmt.Fprint(w, fail.body)
+		return
+	}
+	fmt.Fprintf(w, `{"name":"projects/%s/messages/1"}`, testProjectID)
+}
+
+// FCM 이 실제로 반환하는 형식의 오류 응답입니다.
+const (
+	unregisteredBody = `{"error":{"code":404,"message":"Requested entity was not found.","status":"NOT_FOUND","details":[{"@type":"type.googleapis.com/google.firebase.fcm.v1.FcmError","errorCode":"UNREGISTERED"}]}}`
+	invalidTokenBody = `{"error":{"code":400,"message":"The registration token is not a valid FCM registration token","status":"INVALID_ARGUMENT","details":[{"@type":"type.googleapis.com/google.firebase.fcm.v1.FcmError","errorCode":"INVALID_ARGUMENT"},{"@type":"type.googleapis.com/google.rpc.BadRequest","fieldViolations":[{"field":"message.token","description":"Invalid registration token"}]}]}}`
+	tooLargeBody     = `{"error":{"code":400,"message":"Request contains an invalid argument.","status":"INVALID_ARGUMENT","details":[{"@type":"type.googleapis.com/google.firebase.fcm.v1.FcmError","errorCode":"INVALID_ARGUMENT"},{"@type":"type.googleapis.com/google.rpc.BadRequest","fieldViolations":[{"field":"message","description":"Message is too big"}]}]}}`
+	badDataKeyBody   = `{"error":{"code":400,"message":"Invalid data payload key: from","status":"INVALID_ARGUMENT","details":[{"@type":"type.googleapis.com/google.firebase.fcm.v1.FcmError","errorCode":"INVALID_ARGUMENT"}]}}`
+	unavailableBody  = `{"error":{"code":503,"message":"The service is currently unavailable.","status":"UNAVAILABLE"}}`
+)
+
+func notifications(tokens ...string) []*notification.Notification {
+	list := make([]*notification.Notification, 0, len(tokens))
+	for _, token := range tokens {
+		list = append(list, &notification.Notification{Token: token, Title: "Alice", Body: "안녕하세요"})
+	}
+	return list
+}
+
+func TestAccessTokenCache(t *testing.T) {
+	t.Run("reused until expiry", func(t *testing.T) {
+		f, srv := newFakeFCM(t)
+		n := f.notifier(srv, 1)
+
+		for range 3 {
+			for _, err := range n.Send(context.Background(), notifications("a", "b")) {
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+		if f.tokenRequests != 1 {
+			t.Fatalf("token requests = %d, want 1", f.tokenRequests)
+		}
+	})
+
+	t.Run("refreshed near expiry", func(t *testing.T) {
+		f, srv := newFakeFCM(t)
+		// 갱신 여유 시간(1분)보다 짧으면 매번 새로 발급받습니다.
+		f.expiresIn = 30
+		n := f.notifier(srv, 1)
+
+		for range 2 {
+			n.Send(context.Background(), notifications("a"))
+		}
+		if f.tokenRequests != 2 {
+			t.Fatalf("token requests = %d, want 2", f.tokenRequests)
+		}
+	})
+
+	t.Run("token failure", func(t *testing.T) {
+		f, srv := newFakeFCM(t)
+		f.tokenFail = true
+		n := f.notifier(srv, 1)
+
+		errs := n.Send(context.Background(), notifications("a", "b"))
+		for i, err := range errs {
+			if err == nil || errors.Is(err, notification.ErrInvalidToken) {
+				t.Fatalf("errs[%d] = %v", i, err)
+			}
+		}
+		if len(f.sent) != 0 {
+			t.Fatalf("sent = %v, access token 없이 발송했습니다", f.sent)
+		}
+	})
+}
+
+func TestSendConcurrency(t *testing.T) {
+	f, srv := newFakeFCM(t)
+	f.delay = 20 * time.Millisecond
+	n := f.notifier(srv, 3)
+
+	tokens := make([]string, 12)
+	for i := range tokens {
+		tokens[i] = fmt.Sprintf("device-%d", i)
+	}
+	errs := n.Send(context.Background(), notifications(tokens...))
+	if len(errs) != len(tokens) {
+		t.Fatalf("errs = %d, want %d", len(errs), len(tokens))
+	}
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("errs[%d] = %v", i, err)
+		}
+	}
+	if len(f.sent) != len(tokens) {
+		t.Fatalf("sent = %d, want %d", len(f.sent), len(tokens))
+	}
+	if f.maxInFlight > 3 {
+		t.Fatalf("동시 요청 = %d, Concurrency(3) 를 넘었습니다", f.maxInFlight)
+	}
+}
+
+func TestSendErrors(t *testing.T) {
+	f, srv := newFakeFCM(t)
+	f.failures = map[string]failure{
+		"unregistered":  {http.StatusNotFound, unregisteredBody},
+		"invalid-token": {http.StatusBadRequest, invalidTokenBody},
+		"too-large":     {http.StatusBadRequest, tooLargeBody},
+		"bad-data-key":  {http.StatusBadRequest, badDataKeyBody},
+		"unavailable":   {http.StatusServiceUnavailable, unavailableBody},
+	}
+	n := f.notifier(srv, 2)
+
+	cases := []struct {
+		token        string
+		fails        bool
+		invalidToken bool
+	}{
+		{"ok", false, false},
+		{"unregistered", true, true},
+		{"invalid-token", true, true},
+		// 요청 자체의 오류는 기기 토큰 오류가 아닙니다.
+		{"too-large", true, false},
+		{"bad-data-key", true, false},
+		{"unavailable", true, false},
+	}
+	tokens := make([]string, len(cases))
+	for i, tc := range cases {
+		tokens[i] = tc.token
+	}
+
+	// 결과는 요청한 알림과 같은 순서로 반환됩니다.
+	errs := n.Send(context.Background(), notifications(tokens...))
+	for i, tc := range cases {
+		if (errs[i] != nil) != tc.fails || errors.Is(errs[i], notification.ErrInvalidToken) != tc.invalidToken {
+			t.Errorf("%s: err = %v", tc.token, errs[i])
+		}
+	}
+}
+
+// 토큰 오류를 받은 기기만 삭제되고, 요청 자체의 오류로 실패한 기기는 남아야 합니다.
+func TestInvalidTokenCleanup(t *testing.T) {
+	ctx := context.Background()
+	f, srv := newFakeFCM(t)
+	f.failures = map[string]failure{
+		"dead":    {http.StatusNotFound, unregisteredBody},
+		"healthy": {http.StatusBadRequest, tooLargeBody},
+	}
+
+	store := memory.NewStore()
+	users := &memory.MemoryUserRepository{Store: store}
+	members := &memory.MemoryChatRoomMemberRepository{Store: store}
+	devices := &memory.MemoryDeviceRepository{Store: store}
+
+	var ids []string
+	for _, email := range []string{"sender@example.com", "alice@example.com", "bob@example.com"} {
+		u := &model.User{Name: email, Email: email}
+		if err := users.Create(ctx, u); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, u.UserID)
+	}
+	var list []model.ChatRoomMember
+	for _, id := range ids {
+		list = append(list, model.ChatRoomMember{ChatRoomID: "room", UserID: id, Role: model.ChatRoomRoleMember})
+	}
+	if err := members.AddMembers(ctx, list); err != nil {
+		t.Fatal(err)
+	}
+	for i, token := range []string{"dead", "healthy"} {
+		if err := devices.Upsert(ctx, &model.Device{UserID: ids[i+1], Platform: model.DevicePlatformAndroid, PushToken: token, LastSeenAt: time.Now()}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s := &service.NotificationService{Notifier: f.notifier(srv, 2), MemberRepo: members, DeviceRepo: devices}
+	s.NotifyMessage(ctx, &model.Message{MessageID: "m1", ChatRoomID: "room", UserID: ids[0], Content: "hello", Sender: model.User{Name: "Sender"}})
+	if err := s.Wait(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(f.sent) != 2 {
+		t.Fatalf("sent = %v", f.sent)
+	}
+	if found, _ := devices.FindByUser(ctx, ids[1]); len(found) != 0 {
+		t.Fatalf("devices = %+v, 등록 해제된 기기가 삭제되지 않았습니다", found)
+	}
+	if found, _ := devices.FindByUser(ctx, ids[2]); len(found) != 1 {
+		t.Fatalf("devices = %+v, 정상 기기가 삭제되었습니다", found)
+	}
+}
@@ -0,0 +1,38 @@
+// notification 패키지는 모바일 푸시 알림 발송을 추상화합니다.
+// 구현은 fcm 패키지에서 진행합니다.
+package notification
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrInvalidToken은 기기 토큰이 만료되었거나 등록 해제되어 더 이상 사용할 수 없을 때 반환됩니다.
+// 이 오류를 받은 토큰은 저장소에서 제거해야 합니다.
+var ErrInvalidToken = errors.New("notification: 사용할 수 없는 기기 토큰입니다")
+
+// Notification은 기기 하나에 보낼 푸시 알림입니다.
+type Notification struct {
+	// 수신 기기의 토큰
+	Token string
+	Title string
+	Body  string
+	// 앱에서 화면 이동 등에 사용하는 부가 정보
+	Data map[string]string
+}
+
+// 푸시 알림 발송을 추상화한 인터페이스입니다.
+type Notifier interface {
+	// 알림 제공자의 이름을 반환합니다.
+	Name() string
+
+	// 여러 알림을 한 번에 발송합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - notifications: 발송할 알림 목록
+	//
+	// 반환 값
+	//   - []error: notifications 와 같은 순서의 발송 결과 (성공 시 nil, 토큰이 잘못되었으면 ErrInvalidToken 을 감싼 error)
+	Send(ctx context.Context, notifications []*Notification) []error
+}
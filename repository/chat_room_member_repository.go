@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/B-Bridger/server/model"
+)
+
+// ChatRoomMember 관련 데이터 엑세스를 추상화한 인터페이스입니다.
+type ChatRoomMemberRepository interface {
+	// 채팅방의 특정 멤버를 반환합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - chatRoomID: 채팅방의 고유 ID
+	//   - userID: 사용자의 고유 ID
+	//
+	// 반환 값
+	//   - *ChatRoomMember: 불러온 ChatRoomMember 객체
+	//   - error: 멤버가 아니거나 실패 시 error 메세지
+	FindMember(ctx context.Context, chatRoomID, userID string) (*model.ChatRoomMember, error)
+
+	// 채팅방의 모든 멤버를 사용자 정보와 함께 반환합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - chatRoomID: 채팅방의 고유 ID
+	//
+	// 반환 값
+	//   - []ChatRoomMember: 멤버 목록
+	//   - error: 실패 시 error 메세지
+	FindMembers(ctx context.Context, chatRoomID string) ([]model.ChatRoomMember, error)
+
+	// 채팅방 멤버 레코드를 생성합니다.
+	// 이미 멤버인 사용자는 기존 역할을 유지합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - members: 추가할 ChatRoomMember 목록
+	//
+	// 반환 값
+	//   - error: 실패 시 error 메세지
+	AddMembers(ctx context.Context, members []model.ChatRoomMember) error
+
+	// 채팅방 멤버 레코드를 삭제합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - chatRoomID: 채팅방의 고유 ID
+	//   - userIDs: 내보낼 사용자의 고유 ID 목록
+	//
+	// 반환 값
+	//   - error: 실패 시 error 메세지
+	RemoveMembers(ctx context.Context, chatRoomID string, userIDs []string) error
+
+	// 멤버의 푸시 알림 끄기 설정을 변경합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - chatRoomID: 채팅방의 고유 ID
+	//   - userID: 사용자의 고유 ID
+	//   - muted: true 이면 알림을 받지 않습니다
+	//
+	// 반환 값
+	//   - error: 실패 시 error 메세지
+	SetMuted(ctx context.Context, chatRoomID, userID string, muted bool) error
+}
@@ -1,54 +1,108 @@
 package repository
 
-import "github.com/B-Bridger/server/model"
+import (
+	"context"
+
+	"github.com/B-Bridger/server/model"
+)
 
 // ChatRoom 관련 데이터 엑세스를 추상화한 인터페이스입니다.
 type ChatRoomRepository interface {
-	// ChatRoomID를 통해 ChatRoom 객체를 반환합니다.
+	// ChatRoomID를 통해 ChatRoom 객체를 멤버, 참여 회사 목록과 함께 반환합니다.
 	//
 	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
 	//   - id: 채팅방의 고유 ID
 	//
 	// 반환 값
 	//   - ChatRoom: 불러온 ChatRoom 객체
 	//   - error: 실패 시 error 메세지
-	FindByID(id string) (*model.ChatRoom, error)
+	FindByID(ctx context.Context, id string) (*model.ChatRoom, error)
 
 	// OwnerUserID를 통해 ChatRoom 객체를 반환합니다.
 	//
 	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
 	//   - id: 사용자의 고유 ID
 	//
 	// 반환 값
 	//   - ChatRoom: 불러온 ChatRoom 객체
 	//   - error: 실패 시 error 메세지
-	FindByOwner(id string) (*[]model.ChatRoom, error)
+	FindByOwner(ctx context.Context, id string) (*[]model.ChatRoom, error)
+
+	// 사용자가 멤버로 참여 중인 ChatRoom 목록을 반환합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - id: 사용자의 고유 ID
+	//
+	// 반환 값
+	//   - []ChatRoom: 불러온 ChatRoom 목록
+	//   - error: 실패 시 error 메세지
+	FindByMember(ctx context.Context, id string) (*[]model.ChatRoom, error)
+
+	// 채팅방 참여자(멤버) 목록을 반환합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - id: 채팅방의 고유 ID
+	//
+	// 반환 값
+	//   - []User: 참여자 목록
+	//   - error: 실패 시 error 메세지
+	FindParticipants(ctx context.Context, id string) ([]model.User, error)
 
 	// 채팅방 레코드를 생성합니다.
+	// chatRoom.Members, chatRoom.Companies 가 있으면 같은 트랜잭션 안에서 함께 생성합니다.
 	//
 	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
 	//   - chatRoom: ChatRoom 객체 포인터
 	//
 	// 반환 값
 	//   - error: 실패 시 error 메세지
-	Create(chatRoom *model.ChatRoom) error
+	Create(ctx context.Context, chatRoom *model.ChatRoom) error
 
-	// 기존에 존재하는 채팅방 정보를 변경합니다.
+	// 채팅방에 참여한 회사를 기록합니다. 이미 기록된 회사는 무시합니다.
 	//
 	// 매개 변수
-	//   - chatRoom: ChatRoom 객체 포인터
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - id: 채팅방의 고유 ID
+	//   - companyIDs: 회사의 고유 ID 목록
 	//
 	// 반환 값
-	//   - ChatRoom: 수정된 ChatRoom 객체
 	//   - error: 실패 시 error 메세지
-	Update(chatRoom *model.ChatRoom) (*model.ChatRoom, error)
+	AddCompanies(ctx context.Context, id string, companyIDs []string) error
+
+	// 채팅방 설정(Title, Description, DefaultLanguage, Avatar)만 주어진 값으로 저장합니다.
+	// 소유자, 마지막 메세지, 생성 시각 등 서버가 관리하는 필드는 변경하지 않습니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - chatRoom: ChatRoom 객체 포인터
+	//
+	// 반환 값
+	//   - error: 채팅방이 없으면 ErrChatRoomNotFound, 실패 시 error 메세지
+	UpdateSettings(ctx context.Context, chatRoom *model.ChatRoom) error
+
+	// 채팅방 소유자를 다른 멤버로 변경합니다. 이전 소유자가 멤버로 남아 있으면 관리자가 됩니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - id: 채팅방의 고유 ID
+	//   - userID: 새로운 소유자의 고유 ID
+	//
+	// 반환 값
+	//   - error: 채팅방이 없으면 ErrChatRoomNotFound, 새로운 소유자가 멤버가 아니면 ErrChatRoomMemberNotFound
+	TransferOwnership(ctx context.Context, id, userID string) error
 
-	// 채팅방 레코드를 삭제합니다.
+	// 채팅방 레코드를 멤버, 메세지, 용어집과 함께 삭제합니다.
 	//
 	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
 	//   - id: 채팅방의 고유 ID
 	//
 	// 반환 값
 	//   - error: 실패 시 error 메세지
-	Delete(id string) error
+	Delete(ctx context.Context, id string) error
 }
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/B-Bridger/server/model"
+)
+
+// Company 관련 데이터 엑세스를 추상화한 인터페이스입니다.
+type CompanyRepository interface {
+	// CompanyID를 통해 Company 객체를 반환합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - id: 회사의 고유 ID
+	//
+	// 반환 값
+	//   - *Company: 불러온 Company 객체
+	//   - error: 실패 시 error 메세지
+	FindByID(ctx context.Context, id string) (*model.Company, error)
+
+	// 회사 레코드를 생성하고, 같은 트랜잭션 안에서 생성한 사용자를 회사 관리자로 지정합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - company: Company 객체 포인터
+	//   - adminUserID: 회사 관리자가 될 사용자의 고유 ID
+	//
+	// 반환 값
+	//   - error: 실패 시 error 메세지
+	Create(ctx context.Context, company *model.Company, adminUserID string) error
+
+	// 기존에 존재하는 회사 정보를 수정합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - company: Company 객체 포인터
+	//
+	// 반환 값
+	//   - *Company: 수정된 Company 객체
+	//   - error: 실패 시 error 메세지
+	Update(ctx context.Context, company *model.Company) (*model.Company, error)
+
+	// 회사에 소속된 사용자 목록을 반환합니다.
+	// query 가 주어지면 이름 또는 이메일에 query 가 포함된 사용자만 반환합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - id: 회사의 고유 ID
+	//   - query: 검색어 (전체 조회는 빈 문자열)
+	//
+	// 반환 값
+	//   - []User: 불러온 user 목록
+	//   - error: 실패 시 error 메세지
+	FindUsers(ctx context.Context, id string, query string) ([]model.User, error)
+
+	// 회사 관리자의 고유 ID 목록을 반환합니다.
+	// UnitOfWork 안에서 호출하면 트랜잭션이 끝날 때까지 관리자 행을 잠가,
+	// 동시에 다른 관리자를 변경하는 요청이 마지막 관리자를 없애지 못하도록 합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - id: 회사의 고유 ID
+	//
+	// 반환 값
+	//   - []string: 관리자의 고유 ID 목록
+	//   - error: 실패 시 error 메세지
+	LockAdmins(ctx context.Context, id string) ([]string, error)
+
+	// 사용자의 소속 회사와 역할을 변경합니다.
+	// companyID 가 빈 문자열이면 소속을 해제합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - userID: 사용자의 고유 ID
+	//   - companyID: 회사의 고유 ID
+	//   - role: 회사 내 역할
+	//
+	// 반환 값
+	//   - error: 실패 시 error 메세지
+	SetMembership(ctx context.Context, userID, companyID, role string) error
+
+	// 회사 초대를 생성합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - invitation: CompanyInvitation 객체 포인터
+	//
+	// 반환 값
+	//   - error: 같은 회사, 이메일의 초대가 이미 있으면 ErrDuplicate, 실패 시 error 메세지
+	CreateInvitation(ctx context.Context, invitation *model.CompanyInvitation) error
+
+	// InvitationID를 통해 회사 정보(Company)를 포함한 초대를 반환합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - id: 초대의 고유 ID
+	//
+	// 반환 값
+	//   - *CompanyInvitation: 불러온 CompanyInvitation 객체
+	//   - error: 없으면 ErrCompanyInvitationNotFound, 실패 시 error 메세지
+	FindInvitation(ctx context.Context, id string) (*model.CompanyInvitation, error)
+
+	// 이메일로 받은 초대 목록을 회사 정보(Company)와 함께 오래된 순으로 반환합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - email: 초대받은 이메일
+	//
+	// 반환 값
+	//   - []CompanyInvitation: 불러온 초대 목록
+	//   - error: 실패 시 error 메세지
+	FindInvitationsByEmail(ctx context.Context, email string) ([]model.CompanyInvitation, error)
+
+	// 초대를 삭제합니다. 없는 초대이면 아무것도 하지 않습니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - id: 초대의 고유 ID
+	//
+	// 반환 값
+	//   - error: 실패 시 error 메세지
+	DeleteInvitation(ctx context.Context, id string) error
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/B-Bridger/server/model"
+)
+
+// Device 관련 데이터 엑세스를 추상화한 인터페이스입니다.
+type DeviceRepository interface {
+	// 사용자의 기기 목록을 최근 사용 순으로 반환합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - userID: 사용자의 고유 ID
+	//
+	// 반환 값
+	//   - []Device: 불러온 기기 목록
+	//   - error: 실패 시 error 메세지
+	FindByUser(ctx context.Context, userID string) ([]model.Device, error)
+
+	// 여러 사용자의 기기 중 since 이후에 사용된 기기 목록을 반환합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - userIDs: 사용자의 고유 ID 목록
+	//   - since: 이 시각 이후 사용된 기기만 반환합니다
+	//
+	// 반환 값
+	//   - []Device: 불러온 기기 목록
+	//   - error: 실패 시 error 메세지
+	FindActiveByUsers(ctx context.Context, userIDs []string, since time.Time) ([]model.Device, error)
+
+	// 기기를 등록합니다. 같은 푸시 토큰의 기기가 이미 있으면 주어진 값으로 교체합니다.
+	// (다른 사용자가 같은 기기로 로그인한 경우 기존 등록은 새 사용자에게 넘어갑니다)
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - device: Device 객체 포인터 (DeviceID 는 저장된 값으로 채워집니다)
+	//
+	// 반환 값
+	//   - error: 실패 시 error 메세지
+	Upsert(ctx context.Context, device *model.Device) error
+
+	// 사용자의 기기를 삭제합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - userID: 사용자의 고유 ID
+	//   - deviceID: 기기의 고유 ID
+	//
+	// 반환 값
+	//   - bool: 삭제된 기기가 있으면 true
+	//   - error: 실패 시 error 메세지
+	Delete(ctx context.Context, userID, deviceID string) (bool, error)
+
+	// 로그인(session)에서 등록된 기기를 모두 삭제합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - sessionID: refresh token family ID
+	//
+	// 반환 값
+	//   - error: 실패 시 error 메세지
+	DeleteBySession(ctx context.Context, sessionID string) error
+
+	// 푸시 토큰으로 기기를 삭제합니다. 알림 제공자가 토큰이 잘못되었다고 응답한 경우 사용합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - pushToken: 푸시 토큰
+	//
+	// 반환 값
+	//   - error: 실패 시 error 메세지
+	DeleteByToken(ctx context.Context, pushToken string) error
+
+	// 사용자의 기기를 모두 삭제합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - userID: 사용자의 고유 ID
+	//
+	// 반환 값
+	//   - error: 실패 시 error 메세지
+	DeleteByUser(ctx context.Context, userID string) error
+}
@@ -0,0 +1,23 @@
+package repository
+
+import "github.com/B-Bridger/server/apperror"
+
+// repository 구현이 반환하는 오류입니다.
+// 구현(MariaDB, memory)에 관계없이 같은 상황에서는 같은 오류를 반환해야 하며,
+// 그 외의 데이터베이스 오류는 apperror.Unavailable, apperror.Internal 로 감싸서 반환합니다.
+var (
+	ErrUserNotFound              = apperror.New(apperror.KindNotFound, "user_not_found", "사용자를 찾을 수 없습니다")
+	ErrChatRoomNotFound          = apperror.New(apperror.KindNotFound, "chat_room_not_found", "채팅방을 찾을 수 없습니다")
+	ErrChatRoomMemberNotFound    = apperror.New(apperror.KindNotFound, "chat_room_member_not_found", "채팅방 멤버를 찾을 수 없습니다")
+	ErrMessageNotFound           = apperror.New(apperror.KindNotFound, "message_not_found", "메세지를 찾을 수 없습니다")
+	ErrRefreshTokenNotFound      = apperror.New(apperror.KindNotFound, "refresh_token_not_found", "refresh token 을 찾을 수 없습니다")
+	ErrCompanyNotFound           = apperror.New(apperror.KindNotFound, "company_not_found", "회사를 찾을 수 없습니다")
+	ErrGlossaryTermNotFound      = apperror.New(apperror.KindNotFound, "glossary_term_not_found", "용어를 찾을 수 없습니다")
+	ErrEmailChangeNotFound       = apperror.New(apperror.KindNotFound, "email_change_not_found", "이메일 변경 요청을 찾을 수 없습니다")
+	ErrCompanyInvitationNotFound = apperror.New(apperror.KindNotFound, "company_invitation_not_found", "회사 초대를 찾을 수 없습니다")
+
+	// 사용자 이메일 unique 제약 위반
+	ErrEmailTaken = apperror.New(apperror.KindConflict, "email_taken", "이미 사용 중인 이메일입니다")
+	// 그 외 unique 제약 위반
+	ErrDuplicate = apperror.New(apperror.KindConflict, "duplicate", "이미 존재하는 데이터입니다")
+)
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/B-Bridger/server/model"
+)
+
+// GlossaryTerm 관련 데이터 엑세스를 추상화한 인터페이스입니다.
+type GlossaryRepository interface {
+	// TermID를 통해 GlossaryTerm 객체를 번역어와 함께 반환합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - id: 용어의 고유 ID
+	//
+	// 반환 값
+	//   - *GlossaryTerm: 불러온 GlossaryTerm 객체
+	//   - error: 실패 시 error 메세지
+	FindTermByID(ctx context.Context, id string) (*model.GlossaryTerm, error)
+
+	// 적용 범위에 속한 용어 목록을 번역어와 함께 원문 순으로 반환합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - scope: 적용 범위 (company, chatRoom)
+	//   - scopeIDs: 회사 또는 채팅방의 고유 ID 목록
+	//
+	// 반환 값
+	//   - []GlossaryTerm: 불러온 용어 목록
+	//   - error: 실패 시 error 메세지
+	FindTerms(ctx context.Context, scope string, scopeIDs []string) ([]model.GlossaryTerm, error)
+
+	// 용어 레코드를 번역어와 함께 생성합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - term: GlossaryTerm 객체 포인터
+	//
+	// 반환 값
+	//   - error: 같은 범위에 같은 원문이 있거나 실패 시 error 메세지
+	CreateTerm(ctx context.Context, term *model.GlossaryTerm) error
+
+	// 용어 정보를 수정하고 번역어 목록을 주어진 값으로 교체합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - term: GlossaryTerm 객체 포인터
+	//
+	// 반환 값
+	//   - error: 실패 시 error 메세지
+	UpdateTerm(ctx context.Context, term *model.GlossaryTerm) error
+
+	// 용어와 번역어를 제거합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - id: 용어의 고유 ID
+	//
+	// 반환 값
+	//   - error: 실패 시 error 메세지
+	DeleteTerm(ctx context.Context, id string) error
+
+	// 여러 용어를 하나의 트랜잭션 안에서 저장합니다.
+	// 같은 범위에 같은 원문이 이미 있으면 해당 용어를 주어진 값으로 교체합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - terms: 저장할 GlossaryTerm 목록
+	//
+	// 반환 값
+	//   - error: 실패 시 error 메세지 (실패하면 아무것도 저장되지 않습니다)
+	UpsertTerms(ctx context.Context, terms []model.GlossaryTerm) error
+}
@@ -0,0 +1,52 @@
+package mariaDB
+
+import (
+	"context"
+
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type MariaDBChatRoomMemberRepository struct {
+	DB *gorm.DB
+}
+
+func (r *MariaDBChatRoomMemberRepository) FindMember(ctx context.Context, chatRoomID, userID string) (*model.ChatRoomMember, error) {
+	var member model.ChatRoomMember
+
+	if err := r.DB.WithContext(ctx).Preload("User").First(&member, "chatRoomID = ? AND userID = ?", chatRoomID, userID).Error; err != nil {
+		return nil, translateError(err, repository.ErrChatRoomMemberNotFound)
+	}
+
+	return &member, nil
+}
+
+func (r *MariaDBChatRoomMemberRepository) FindMembers(ctx context.Context, chatRoomID string) ([]model.ChatRoomMember, error) {
+	var members []model.ChatRoomMember
+
+	if err := r.DB.WithContext(ctx).Preload("User").Order("joinedAt").Find(&members, "chatRoomID = ?", chatRoomID).Error; err != nil {
+		return nil, translateError(err, nil)
+	}
+
+	return members, nil
+}
+
+func (r *MariaDBChatRoomMemberRepository) AddMembers(ctx context.Context, members []model.ChatRoomMember) error {
+	if len(members) == 0 {
+		return nil
+	}
+	return translateError(r.DB.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&members).Error, nil)
+}
+
+func (r *MariaDBChatRoomMemberRepository) RemoveMembers(ctx context.Context, chatRoomID string, userIDs []string) error {
+	return translateError(r.DB.WithContext(ctx).Delete(&model.ChatRoomMember{}, "chatRoomID = ? AND userID IN ?", chatRoomID, userIDs).Error, nil)
+}
+
+func (r *MariaDBChatRoomMemberRepository) SetMuted(ctx context.Context, chatRoomID, userID string, muted bool) error {
+	return translateError(r.DB.WithContext(ctx).Model(&model.ChatRoomMember{}).
+		Where("chatRoomID = ? AND userID = ?", chatRoomID, userID).
+		Update("muted", muted).
+		Error, nil)
+}
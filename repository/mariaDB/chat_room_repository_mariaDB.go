@@ -1,8 +1,12 @@
 package mariaDB
 
 import (
+	"context"
+
 	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/repository"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type MariaDBChatRoomRepository struct {
@@ -10,37 +14,137 @@ type MariaDBChatRoomRepository struct {
 }
 
 // TODO: Owner 불러오기 실행
-func (r *MariaDBChatRoomRepository) FindByID(id string) (*model.ChatRoom, error) {
+func (r *MariaDBChatRoomRepository) FindByID(ctx context.Context, id string) (*model.ChatRoom, error) {
 	var chatRoom model.ChatRoom
 
-	if err := r.DB.Preload("Owner").First(&chatRoom, "chatRoomID = ?", id).Error; err != nil {
-		return nil, err
+	if err := r.DB.WithContext(ctx).Preload("Owner").Preload("Members.User").Preload("Companies.Company").First(&chatRoom, "chatRoomID = ?", id).Error; err != nil {
+		return nil, translateError(err, repository.ErrChatRoomNotFound)
 	}
 
 	return &chatRoom, nil
 }
 
-func (r *MariaDBChatRoomRepository) FindByOwner(id string) (*[]model.ChatRoom, error) {
+func (r *MariaDBChatRoomRepository) FindByOwner(ctx context.Context, id string) (*[]model.ChatRoom, error) {
 	var chatRooms []model.ChatRoom
 
-	if err := r.DB.Preload("Owner").Find(&chatRooms, "ownerUserID = ?", id).Error; err != nil {
-		return nil, err
+	if err := r.DB.WithContext(ctx).Preload("Owner").Find(&chatRooms, "ownerUserID = ?", id).Error; err != nil {
+		return nil, translateError(err, nil)
 	}
 
 	return &chatRooms, nil
 }
 
-func (r *MariaDBChatRoomRepository) Create(chatRoom *model.ChatRoom) error {
-	return r.DB.Create(chatRoom).Error
+func (r *MariaDBChatRoomRepository) FindByMember(ctx context.Context, id string) (*[]model.ChatRoom, error) {
+	var chatRooms []model.ChatRoom
+
+	memberOf := r.DB.WithContext(ctx).Model(&model.ChatRoomMember{}).Select("chatRoomID").Where("userID = ?", id)
+	if err := r.DB.WithContext(ctx).Preload("Owner").Where("chatRoomID IN (?)", memberOf).Order("lastMessageAt DESC").Find(&chatRooms).Error; err != nil {
+		return nil, translateError(err, nil)
+	}
+
+	return &chatRooms, nil
+}
+
+func (r *MariaDBChatRoomRepository) FindParticipants(ctx context.Context, id string) ([]model.User, error) {
+	var users []model.User
+
+	members := r.DB.WithContext(ctx).Model(&model.ChatRoomMember{}).Select("userID").Where("chatRoomID = ?", id)
+	if err := r.DB.WithContext(ctx).Where("userID IN (?)", members).Find(&users).Error; err != nil {
+		return nil, translateError(err, nil)
+	}
+
+	return users, nil
+}
+
+func (r *MariaDBChatRoomRepository) Create(ctx context.Context, chatRoom *model.ChatRoom) error {
+	return translateError(r.DB.WithContext(ctx).Create(chatRoom).Error, nil)
 }
 
-func (r *MariaDBChatRoomRepository) Update(chatRoom *model.ChatRoom) (*model.ChatRoom, error) {
-	if err := r.DB.Save(chatRoom).Error; err != nil {
-		return nil, err
+func (r *MariaDBChatRoomRepository) AddCompanies(ctx context.Context, id string, companyIDs []string) error {
+	if len(companyIDs) == 0 {
+		return nil
+	}
+
+	companies := make([]model.ChatRoomCompany, 0, len(companyIDs))
+	for _, companyID := range companyIDs {
+		companies = append(companies, model.ChatRoomCompany{ChatRoomID: id, CompanyID: companyID})
 	}
-	return chatRoom, nil
+	return translateError(r.DB.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&companies).Error, nil)
+}
+
+func (r *MariaDBChatRoomRepository) UpdateSettings(ctx context.Context, chatRoom *model.ChatRoom) error {
+	// 구조체와 달리 map 으로 지정한 열은 빈 값이어도 저장됩니다.
+	result := r.DB.WithContext(ctx).Model(&model.ChatRoom{ChatRoomID: chatRoom.ChatRoomID}).
+		Updates(map[string]any{
+			"title":           chatRoom.Title,
+			"description":     chatRoom.Description,
+			"defaultLanguage": chatRoom.DefaultLanguage,
+			"avatar":          chatRoom.Avatar,
+		})
+	if result.Error != nil {
+		return translateError(result.Error, nil)
+	}
+	// MySQL 은 값이 바뀐 행만 세므로, 0 이면 채팅방이 있는지 다시 확인합니다.
+	if result.RowsAffected == 0 {
+		var count int64
+		if err := r.DB.WithContext(ctx).Model(&model.ChatRoom{}).Where("chatRoomID = ?", chatRoom.ChatRoomID).Count(&count).Error; err != nil {
+			return translateError(err, nil)
+		}
+		if count == 0 {
+			return repository.ErrChatRoomNotFound
+		}
+	}
+	return nil
+}
+
+func (r *MariaDBChatRoomRepository) TransferOwnership(ctx context.Context, id, userID string) error {
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var chatRoom model.ChatRoom
+		if err := tx.Select("chatRoomID", "ownerUserID").First(&chatRoom, "chatRoomID = ?", id).Error; err != nil {
+			return err
+		}
+		if chatRoom.UserID == userID {
+			return nil
+		}
+
+		result := tx.Model(&model.ChatRoomMember{}).Where("chatRoomID = ? AND userID = ?", id, userID).Update("role", model.ChatRoomRoleOwner)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return repository.ErrChatRoomMemberNotFound
+		}
+		if err := tx.Model(&model.ChatRoomMember{}).Where("chatRoomID = ? AND userID = ?", id, chatRoom.UserID).Update("role", model.ChatRoomRoleAdmin).Error; err != nil {
+			return err
+		}
+		return tx.Model(&model.ChatRoom{}).Where("chatRoomID = ?", id).Update("ownerUserID", userID).Error
+	})
+	return translateError(err, repository.ErrChatRoomNotFound)
 }
 
-func (r *MariaDBChatRoomRepository) Delete(id string) error {
-	return r.DB.Delete(&model.ChatRoom{}, "chatRoomID = ?", id).Error
+func (r *MariaDBChatRoomRepository) Delete(ctx context.Context, id string) error {
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		messages := tx.Model(&model.Message{}).Select("messageID").Where("chatRoomID = ?", id)
+		if err := tx.Delete(&model.MessageTranslation{}, "messageID IN (?)", messages).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&model.Message{}, "chatRoomID = ?", id).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&model.ChatRoomMember{}, "chatRoomID = ?", id).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&model.ChatRoomCompany{}, "chatRoomID = ?", id).Error; err != nil {
+			return err
+		}
+		terms := tx.Model(&model.GlossaryTerm{}).Select("termID").Where("scope = ? AND scopeID = ?", model.GlossaryScopeChatRoom, id)
+		if err := tx.Delete(&model.GlossaryTarget{}, "termID IN (?)", terms).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&model.GlossaryTerm{}, "scope = ? AND scopeID = ?", model.GlossaryScopeChatRoom, id).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&model.ChatRoom{}, "chatRoomID = ?", id).Error
+	})
+	return translateError(err, nil)
 }
@@ -0,0 +1,107 @@
+package mariaDB
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/repository"
+)
+
+// 채팅방 설정만 저장하고, 서버가 관리하는 필드는 그대로 유지해야 합니다.
+func TestChatRoomUpdateSettings(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	users := &MariaDBUserRepository{DB: db}
+	chatRooms := &MariaDBChatRoomRepository{DB: db}
+
+	owner := &model.User{Name: "Owner", Email: "owner@example.com"}
+	if err := users.Create(ctx, owner); err != nil {
+		t.Fatal(err)
+	}
+	lastMessageAt := time.Now().Truncate(time.Second)
+	chatRoom := &model.ChatRoom{UserID: owner.UserID, Title: "old", Description: "설명", LastMessage: "hello", LastMessageAt: lastMessageAt}
+	if err := chatRooms.Create(ctx, chatRoom); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := chatRooms.UpdateSettings(ctx, &model.ChatRoom{ChatRoomID: chatRoom.ChatRoomID, Title: "new", LastMessage: "spoofed"}); err != nil {
+		t.Fatal(err)
+	}
+	found, err := chatRooms.FindByID(ctx, chatRoom.ChatRoomID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found.Title != "new" || found.Description != "" {
+		t.Fatalf("title = %q, description = %q", found.Title, found.Description)
+	}
+	if found.LastMessage != "hello" || !found.LastMessageAt.Equal(lastMessageAt) || found.UserID != owner.UserID {
+		t.Fatalf("chatRoom = %+v", found)
+	}
+
+	// 값이 바뀌지 않아도 성공하며, 없는 채팅방은 ErrChatRoomNotFound 를 반환합니다.
+	if err := chatRooms.UpdateSettings(ctx, found); err != nil {
+		t.Fatal(err)
+	}
+	if err := chatRooms.UpdateSettings(ctx, &model.ChatRoom{ChatRoomID: "unknown"}); !errors.Is(err, repository.ErrChatRoomNotFound) {
+		t.Fatalf("err = %v, want ErrChatRoomNotFound", err)
+	}
+}
+
+// 소유자를 넘기면 새로운 소유자의 역할이 owner, 이전 소유자는 admin 이 되어야 합니다.
+func TestChatRoomTransferOwnership(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	users := &MariaDBUserRepository{DB: db}
+	chatRooms := &MariaDBChatRoomRepository{DB: db}
+	members := &MariaDBChatRoomMemberRepository{DB: db}
+
+	owner := &model.User{Name: "Owner", Email: "owner@example.com"}
+	member := &model.User{Name: "Member", Email: "member@example.com"}
+	for _, u := range []*model.User{owner, member} {
+		if err := users.Create(ctx, u); err != nil {
+			t.Fatal(err)
+		}
+	}
+	chatRoom := &model.ChatRoom{UserID: owner.UserID}
+	if err := chatRooms.Create(ctx, chatRoom); err != nil {
+		t.Fatal(err)
+	}
+	if err := members.AddMembers(ctx, []model.ChatRoomMember{
+		{ChatRoomID: chatRoom.ChatRoomID, UserID: owner.UserID, Role: model.ChatRoomRoleOwner},
+		{ChatRoomID: chatRoom.ChatRoomID, UserID: member.UserID, Role: model.ChatRoomRoleMember},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := chatRooms.TransferOwnership(ctx, chatRoom.ChatRoomID, "unknown"); !errors.Is(err, repository.ErrChatRoomMemberNotFound) {
+		t.Fatalf("err = %v, want ErrChatRoomMemberNotFound", err)
+	}
+	if err := chatRooms.TransferOwnership(ctx, "unknown", member.UserID); !errors.Is(err, repository.ErrChatRoomNotFound) {
+		t.Fatalf("err = %v, want ErrChatRoomNotFound", err)
+	}
+	if err := chatRooms.TransferOwnership(ctx, chatRoom.ChatRoomID, member.UserID); err != nil {
+		t.Fatal(err)
+	}
+
+	found, err := chatRooms.FindByID(ctx, chatRoom.ChatRoomID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found.UserID != member.UserID {
+		t.Fatalf("owner = %q, want %q", found.UserID, member.UserID)
+	}
+	roles := map[string]string{}
+	list, err := members.FindMembers(ctx, chatRoom.ChatRoomID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range list {
+		roles[m.UserID] = m.Role
+	}
+	if roles[member.UserID] != model.ChatRoomRoleOwner || roles[owner.UserID] != model.ChatRoomRoleAdmin {
+		t.Fatalf("roles = %v", roles)
+	}
+}
@@ -0,0 +1,109 @@
+package mariaDB
+
+import (
+	"context"
+
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type MariaDBCompanyRepository struct {
+	DB *gorm.DB
+}
+
+func (r *MariaDBCompanyRepository) FindByID(ctx context.Context, id string) (*model.Company, error) {
+	var company model.Company
+
+	if err := r.DB.WithContext(ctx).First(&company, "companyID = ?", id).Error; err != nil {
+		return nil, translateError(err, repository.ErrCompanyNotFound)
+	}
+
+	return &company, nil
+}
+
+func (r *MariaDBCompanyRepository) Create(ctx context.Context, company *model.Company, adminUserID string) error {
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(company).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&model.User{}).
+			Where("userID = ?", adminUserID).
+			Updates(map[string]interface{}{"companyID": company.CompanyID, "companyRole": model.CompanyRoleAdmin}).
+			Error
+	})
+	return translateError(err, nil)
+}
+
+func (r *MariaDBCompanyRepository) Update(ctx context.Context, company *model.Company) (*model.Company, error) {
+	if err := r.DB.WithContext(ctx).Save(company).Error; err != nil {
+		return nil, translateError(err, nil)
+	}
+	return company, nil
+}
+
+func (r *MariaDBCompanyRepository) FindUsers(ctx context.Context, id string, query string) ([]model.User, error) {
+	var users []model.User
+
+	tx := r.DB.WithContext(ctx).Where("companyID = ?", id)
+	if query != "" {
+		like := "%" + query + "%"
+		tx = tx.Where("name LIKE ? OR email LIKE ?", like, like)
+	}
+	if err := tx.Order("name").Find(&users).Error; err != nil {
+		return nil, translateError(err, nil)
+	}
+
+	return users, nil
+}
+
+func (r *MariaDBCompanyRepository) LockAdmins(ctx context.Context, id string) ([]string, error) {
+	var userIDs []string
+
+	if err := r.DB.WithContext(ctx).Model(&model.User{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("companyID = ? AND companyRole = ?", id, model.CompanyRoleAdmin).
+		Order("userID").
+		Pluck("userID", &userIDs).Error; err != nil {
+		return nil, translateError(err, nil)
+	}
+
+	return userIDs, nil
+}
+
+func (r *MariaDBCompanyRepository) SetMembership(ctx context.Context, userID, companyID, role string) error {
+	return translateError(r.DB.WithContext(ctx).Model(&model.User{}).
+		Where("userID = ?", userID).
+		Updates(map[string]interface{}{"companyID": companyID, "companyRole": role}).
+		Error, nil)
+}
+
+func (r *MariaDBCompanyRepository) CreateInvitation(ctx context.Context, invitation *model.CompanyInvitation) error {
+	return translateError(r.DB.WithContext(ctx).Omit("Company").Create(invitation).Error, nil)
+}
+
+func (r *MariaDBCompanyRepository) FindInvitation(ctx context.Context, id string) (*model.CompanyInvitation, error) {
+	var invitation model.CompanyInvitation
+
+	if err := r.DB.WithContext(ctx).Preload("Company").First(&invitation, "invitationID = ?", id).Error; err != nil {
+		return nil, translateError(err, repository.ErrCompanyInvitationNotFound)
+	}
+
+	return &invitation, nil
+}
+
+func (r *MariaDBCompanyRepository) FindInvitationsByEmail(ctx context.Context, email string) ([]model.CompanyInvitation, error) {
+	var invitations []model.CompanyInvitation
+
+	if err := r.DB.WithContext(ctx).Preload("Company").Where("email = ?", email).Order("createdAt, invitationID").Find(&invitations).Error; err != nil {
+		return nil, translateError(err, nil)
+	}
+
+	return invitations, nil
+}
+
+func (r *MariaDBCompanyRepository) DeleteInvitation(ctx context.Context, id string) error {
+	return translateError(r.DB.WithContext(ctx).Delete(&model.CompanyInvitation{}, "invitationID = ?", id).Error, nil)
+}
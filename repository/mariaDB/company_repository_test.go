@@ -0,0 +1,112 @@
+package mariaDB
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/repository"
+)
+
+// 초대는 회사, 이메일마다 하나만 저장되고 회사 정보와 함께 조회되어야 합니다.
+func TestCompanyInvitations(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	users := &MariaDBUserRepository{DB: db}
+	companies := &MariaDBCompanyRepository{DB: db}
+
+	admin := &model.User{Name: "Admin", Email: "admin@acme.com"}
+	if err := users.Create(ctx, admin); err != nil {
+		t.Fatal(err)
+	}
+	acme := &model.Company{Name: "Acme"}
+	if err := companies.Create(ctx, acme, admin.UserID); err != nil {
+		t.Fatal(err)
+	}
+	other := &model.Company{Name: "Other"}
+	if err := companies.Create(ctx, other, admin.UserID); err != nil {
+		t.Fatal(err)
+	}
+
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	invite := func(companyID string) (*model.CompanyInvitation, error) {
+		invitation := &model.CompanyInvitation{CompanyID: companyID, Email: "bob@acme.com", Role: model.CompanyRoleMember, InvitedBy: admin.UserID, ExpiresAt: expiresAt}
+		return invitation, companies.CreateInvitation(ctx, invitation)
+	}
+	first, err := invite(acme.CompanyID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := invite(acme.CompanyID); !errors.Is(err, repository.ErrDuplicate) {
+		t.Fatalf("err = %v, want ErrDuplicate", err)
+	}
+	if _, err := invite(other.CompanyID); err != nil {
+		t.Fatal(err)
+	}
+
+	found, err := companies.FindInvitation(ctx, first.InvitationID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found.Company.Name != "Acme" || found.Role != model.CompanyRoleMember || !found.ExpiresAt.Equal(expiresAt) {
+		t.Fatalf("invitation = %+v", found)
+	}
+
+	invitations, err := companies.FindInvitationsByEmail(ctx, "bob@acme.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(invitations) != 2 || invitations[0].Company.Name == "" || invitations[1].Company.Name == "" {
+		t.Fatalf("invitations = %+v", invitations)
+	}
+
+	if err := companies.DeleteInvitation(ctx, first.InvitationID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := companies.FindInvitation(ctx, first.InvitationID); !errors.Is(err, repository.ErrCompanyInvitationNotFound) {
+		t.Fatalf("err = %v, want ErrCompanyInvitationNotFound", err)
+	}
+	// 삭제 후에는 같은 이메일을 다시 초대할 수 있습니다.
+	if _, err := invite(acme.CompanyID); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// LockAdmins는 트랜잭션 안에서 관리자만 정렬하여 반환해야 합니다.
+func TestCompanyLockAdmins(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	users := &MariaDBUserRepository{DB: db}
+	companies := &MariaDBCompanyRepository{DB: db}
+
+	alice := &model.User{Name: "Alice", Email: "alice@acme.com"}
+	bob := &model.User{Name: "Bob", Email: "bob@acme.com"}
+	for _, u := range []*model.User{alice, bob} {
+		if err := users.Create(ctx, u); err != nil {
+			t.Fatal(err)
+		}
+	}
+	acme := &model.Company{Name: "Acme"}
+	if err := companies.Create(ctx, acme, alice.UserID); err != nil {
+		t.Fatal(err)
+	}
+	if err := companies.SetMembership(ctx, bob.UserID, acme.CompanyID, model.CompanyRoleMember); err != nil {
+		t.Fatal(err)
+	}
+
+	err := (&MariaDBUnitOfWork{DB: db}).Do(ctx, func(ctx context.Context, repos *repository.Repositories) error {
+		admins, err := repos.Companies.LockAdmins(ctx, acme.CompanyID)
+		if err != nil {
+			return err
+		}
+		if len(admins) != 1 || admins[0] != alice.UserID {
+			t.Fatalf("admins = %v, want [%s]", admins, alice.UserID)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
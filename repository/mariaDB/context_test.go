@@ -0,0 +1,70 @@
+package mariaDB
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/B-Bridger/server/apperror"
+	"github.com/B-Bridger/server/config"
+	"github.com/B-Bridger/server/database"
+	"github.com/B-Bridger/server/database/migration"
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/repository"
+	"gorm.io/gorm"
+)
+
+// newTestDB는 마이그레이션을 적용한 in-memory SQLite 데이터베이스를 생성합니다.
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := database.Connection(config.Database{Driver: config.DriverSQLite, Path: ":memory:"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	migrator, err := migration.New(db, migration.All())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := migrator.Up(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+// 취소된 요청의 context 로는 쿼리를 실행하지 않고 요청 취소 오류를 반환해야 합니다.
+func TestCanceledContext(t *testing.T) {
+	repo := &MariaDBUserRepository{DB: newTestDB(t)}
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		kind apperror.Kind
+		code string
+	}{
+		{name: "canceled", ctx: canceled, kind: apperror.KindCanceled, code: "request_canceled"},
+		{name: "deadline exceeded", ctx: expired, kind: apperror.KindUnavailable, code: "request_timeout"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			email := tt.name + "@example.com"
+			err := repo.Create(tt.ctx, &model.User{Name: tt.name, Email: email})
+			if appErr := apperror.From(err); appErr.Kind != tt.kind || appErr.Code != tt.code {
+				t.Fatalf("err = %v, want %s", err, tt.code)
+			}
+			if _, err := repo.FindByEmail(context.Background(), email); !errors.Is(err, repository.ErrUserNotFound) {
+				t.Fatalf("err = %v, 취소된 요청의 사용자가 저장되었습니다", err)
+			}
+
+			if _, err := repo.FindByEmail(tt.ctx, email); apperror.From(err).Code != tt.code {
+				t.Fatalf("err = %v, want %s", err, tt.code)
+			}
+		})
+	}
+}
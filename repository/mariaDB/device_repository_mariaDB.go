@@ -0,0 +1,83 @@
+package mariaDB
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/B-Bridger/server/model"
+	"gorm.io/gorm"
+)
+
+type MariaDBDeviceRepository struct {
+	DB *gorm.DB
+}
+
+func (r *MariaDBDeviceRepository) FindByUser(ctx context.Context, userID string) ([]model.Device, error) {
+	var devices []model.Device
+
+	if err := r.DB.WithContext(ctx).Where("userID = ?", userID).Order("lastSeenAt DESC").Find(&devices).Error; err != nil {
+		return nil, translateError(err, nil)
+	}
+
+	return devices, nil
+}
+
+func (r *MariaDBDeviceRepository) FindActiveByUsers(ctx context.Context, userIDs []string, since time.Time) ([]model.Device, error) {
+	var devices []model.Device
+	if len(userIDs) == 0 {
+		return devices, nil
+	}
+
+	if err := r.DB.WithContext(ctx).Where("userID IN ? AND lastSeenAt >= ?", userIDs, since).Find(&devices).Error; err != nil {
+		return nil, translateError(err, nil)
+	}
+
+	return devices, nil
+}
+
+func (r *MariaDBDeviceRepository) Upsert(ctx context.Context, device *model.Device) error {
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing model.Device
+		err := tx.Select("deviceID").Where("pushToken = ?", device.PushToken).First(&existing).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return tx.Create(device).Error
+		}
+		if err != nil {
+			return err
+		}
+
+		device.DeviceID = existing.DeviceID
+		return tx.Model(&model.Device{}).
+			Where("deviceID = ?", device.DeviceID).
+			Updates(map[string]interface{}{
+				"userID":     device.UserID,
+				"sessionID":  device.SessionID,
+				"platform":   device.Platform,
+				"appVersion": device.AppVersion,
+				"locale":     device.Locale,
+				"lastSeenAt": device.LastSeenAt,
+			}).Error
+	})
+	return translateError(err, nil)
+}
+
+func (r *MariaDBDeviceRepository) Delete(ctx context.Context, userID, deviceID string) (bool, error) {
+	result := r.DB.WithContext(ctx).Delete(&model.Device{}, "userID = ? AND deviceID = ?", userID, deviceID)
+	if result.Error != nil {
+		return false, translateError(result.Error, nil)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *MariaDBDeviceRepository) DeleteBySession(ctx context.Context, sessionID string) error {
+	return translateError(r.DB.WithContext(ctx).Delete(&model.Device{}, "sessionID = ?", sessionID).Error, nil)
+}
+
+func (r *MariaDBDeviceRepository) DeleteByToken(ctx context.Context, pushToken string) error {
+	return translateError(r.DB.WithContext(ctx).Delete(&model.Device{}, "pushToken = ?", pushToken).Error, nil)
+}
+
+func (r *MariaDBDeviceRepository) DeleteByUser(ctx context.Context, userID string) error {
+	return translateError(r.DB.WithContext(ctx).Delete(&model.Device{}, "userID = ?", userID).Error, nil)
+}
@@ -0,0 +1,49 @@
+package mariaDB
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"net"
+
+	"github.com/B-Bridger/server/apperror"
+	"github.com/B-Bridger/server/repository"
+	"github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+)
+
+// translateError는 gorm 오류를 repository, apperror 오류로 변환합니다.
+// 이미 변환된 오류(트랜잭션 안에서 반환한 오류 등)는 그대로 반환합니다.
+//
+// 매개 변수
+//   - err: gorm 이 반환한 오류
+//   - notFound: 레코드가 없을 때 반환할 오류 (First 등 단건 조회에서만 지정)
+func translateError(err error, notFound error) error {
+	var appErr *apperror.Error
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &appErr):
+		return err
+	case errors.Is(err, gorm.ErrRecordNotFound) && notFound != nil:
+		return notFound
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return repository.ErrDuplicate.Wrap(err)
+	case errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded):
+		return apperror.Canceled(err)
+	case isUnavailable(err):
+		return apperror.Unavailable(err)
+	default:
+		return apperror.Internal(err)
+	}
+}
+
+// isUnavailable은 데이터베이스에 연결할 수 없어 발생한 오류인지 반환합니다.
+func isUnavailable(err error) bool {
+	var netErr net.Error
+	return errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, sql.ErrConnDone) ||
+		errors.Is(err, mysql.ErrInvalidConn) ||
+		errors.As(err, &netErr)
+}
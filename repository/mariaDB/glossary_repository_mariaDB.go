@@ -0,0 +1,113 @@
+package mariaDB
+
+import (
+	"context"
+	"errors"
+
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/repository"
+	"gorm.io/gorm"
+)
+
+type MariaDBGlossaryRepository struct {
+	DB *gorm.DB
+}
+
+func (r *MariaDBGlossaryRepository) FindTermByID(ctx context.Context, id string) (*model.GlossaryTerm, error) {
+	var term model.GlossaryTerm
+
+	if err := r.DB.WithContext(ctx).Preload("Targets").First(&term, "termID = ?", id).Error; err != nil {
+		return nil, translateError(err, repository.ErrGlossaryTermNotFound)
+	}
+
+	return &term, nil
+}
+
+func (r *MariaDBGlossaryRepository) FindTerms(ctx context.Context, scope string, scopeIDs []string) ([]model.GlossaryTerm, error) {
+	var terms []model.GlossaryTerm
+	if len(scopeIDs) == 0 {
+		return terms, nil
+	}
+
+	if err := r.DB.WithContext(ctx).Preload("Targets").
+		Where("scope = ? AND scopeID IN ?", scope, scopeIDs).
+		Order("source").
+		Find(&terms).Error; err != nil {
+		return nil, translateError(err, nil)
+	}
+
+	return terms, nil
+}
+
+func (r *MariaDBGlossaryRepository) CreateTerm(ctx context.Context, term *model.GlossaryTerm) error {
+	return translateError(r.DB.WithContext(ctx).Create(term).Error, nil)
+}
+
+func (r *MariaDBGlossaryRepository) UpdateTerm(ctx context.Context, term *model.GlossaryTerm) error {
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return replaceTerm(tx, term)
+	})
+	return translateError(err, nil)
+}
+
+func (r *MariaDBGlossaryRepository) DeleteTerm(ctx context.Context, id string) error {
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("termID = ?", id).Delete(&model.GlossaryTarget{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("termID = ?", id).Delete(&model.GlossaryTerm{}).Error
+	})
+	return translateError(err, nil)
+}
+
+func (r *MariaDBGlossaryRepository) UpsertTerms(ctx context.Context, terms []model.GlossaryTerm) error {
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i := range terms {
+			term := &terms[i]
+
+			var existing model.GlossaryTerm
+			err := tx.Select("termID").
+				Where("scope = ? AND scopeID = ? AND source = ?", term.Scope, term.ScopeID, term.Source).
+				First(&existing).Error
+			switch {
+			case errors.Is(err, gorm.ErrRecordNotFound):
+				if err := tx.Create(term).Error; err != nil {
+					return err
+				}
+			case err != nil:
+				return err
+			default:
+				term.TermID = existing.TermID
+				if err := replaceTerm(tx, term); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	return translateError(err, nil)
+}
+
+// replaceTerm은 트랜잭션 안에서 용어 정보를 수정하고 번역어 목록을 교체합니다.
+func replaceTerm(tx *gorm.DB, term *model.GlossaryTerm) error {
+	if err := tx.Model(&model.GlossaryTerm{}).
+		Where("termID = ?", term.TermID).
+		Updates(map[string]interface{}{
+			"source":         term.Source,
+			"caseSensitive":  term.CaseSensitive,
+			"doNotTranslate": term.DoNotTranslate,
+		}).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Where("termID = ?", term.TermID).Delete(&model.GlossaryTarget{}).Error; err != nil {
+		return err
+	}
+	if len(term.Targets) == 0 {
+		return nil
+	}
+	for i := range term.Targets {
+		term.Targets[i].TermID = term.TermID
+	}
+	return tx.Create(&term.Targets).Error
+}
@@ -0,0 +1,62 @@
+package mariaDB
+
+import (
+	"context"
+
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/repository"
+	"gorm.io/gorm"
+)
+
+type MariaDBMessageRepository struct {
+	DB *gorm.DB
+}
+
+func (r *MariaDBMessageRepository) FindByID(ctx context.Context, id string) (*model.Message, error) {
+	var message model.Message
+
+	if err := r.DB.WithContext(ctx).Preload("Sender").Preload("Translations").First(&message, "messageID = ?", id).Error; err != nil {
+		return nil, translateError(err, repository.ErrMessageNotFound)
+	}
+
+	return &message, nil
+}
+
+func (r *MariaDBMessageRepository) FindByChatRoom(ctx context.Context, chatRoomID string, cursor string, limit int) ([]model.Message, error) {
+	query := r.DB.WithContext(ctx).Preload("Sender").Preload("Translations").Where("chatRoomID = ?", chatRoomID)
+
+	if cursor != "" {
+		var last model.Message
+		if err := r.DB.WithContext(ctx).Select("messageID", "createdAt").
+			First(&last, "messageID = ? AND chatRoomID = ?", cursor, chatRoomID).Error; err != nil {
+			return nil, translateError(err, repository.ErrMessageNotFound)
+		}
+		// createdAt 이 같은 메세지가 있을 수 있으므로 messageID 로 순서를 고정합니다.
+		query = query.Where("(createdAt < ?) OR (createdAt = ? AND messageID < ?)", last.CreatedAt, last.CreatedAt, last.MessageID)
+	}
+
+	var messages []model.Message
+	if err := query.Order("createdAt DESC").Order("messageID DESC").Limit(limit).Find(&messages).Error; err != nil {
+		return nil, translateError(err, nil)
+	}
+
+	return messages, nil
+}
+
+func (r *MariaDBMessageRepository) Create(ctx context.Context, message *model.Message) error {
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// message.Translations 도 함께 저장됩니다.
+		if err := tx.Create(message).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&model.ChatRoom{}).
+			Where("chatRoomID = ?", message.ChatRoomID).
+			Updates(map[string]interface{}{
+				"lastMessage":   message.Content,
+				"lastMessageAt": message.CreatedAt,
+			}).
+			Error
+	})
+	return translateError(err, nil)
+}
@@ -0,0 +1,86 @@
+package mariaDB
+
+import (
+	"context"
+	"time"
+
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type MariaDBTokenRepository struct {
+	DB *gorm.DB
+}
+
+func (r *MariaDBTokenRepository) CreateRefreshToken(ctx context.Context, token *model.RefreshToken) error {
+	return translateError(r.DB.WithContext(ctx).Create(token).Error, nil)
+}
+
+func (r *MariaDBTokenRepository) FindRefreshTokenByHash(ctx context.Context, hash string) (*model.RefreshToken, error) {
+	var token model.RefreshToken
+
+	if err := r.DB.WithContext(ctx).First(&token, "tokenHash = ?", hash).Error; err != nil {
+		return nil, translateError(err, repository.ErrRefreshTokenNotFound)
+	}
+
+	return &token, nil
+}
+
+func (r *MariaDBTokenRepository) RevokeRefreshToken(ctx context.Context, tokenID string) (bool, error) {
+	result := r.DB.WithContext(ctx).Model(&model.RefreshToken{}).
+		Where("tokenID = ? AND revokedAt IS NULL", tokenID).
+		Update("revokedAt", time.Now())
+	if result.Error != nil {
+		return false, translateError(result.Error, nil)
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+func (r *MariaDBTokenRepository) RevokeFamily(ctx context.Context, familyID string) error {
+	return translateError(r.DB.WithContext(ctx).Model(&model.RefreshToken{}).
+		Where("familyID = ? AND revokedAt IS NULL", familyID).
+		Update("revokedAt", time.Now()).
+		Error, nil)
+}
+
+func (r *MariaDBTokenRepository) RevokeOtherFamilies(ctx context.Context, userID, keepFamilyID string) ([]string, error) {
+	var familyIDs []string
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		query := tx.Model(&model.RefreshToken{}).
+			Where("userID = ? AND familyID <> ? AND revokedAt IS NULL", userID, keepFamilyID)
+		if err := query.Distinct().Pluck("familyID", &familyIDs).Error; err != nil {
+			return err
+		}
+		if len(familyIDs) == 0 {
+			return nil
+		}
+		return tx.Model(&model.RefreshToken{}).
+			Where("userID = ? AND familyID IN ? AND revokedAt IS NULL", userID, familyIDs).
+			Update("revokedAt", time.Now()).
+			Error
+	})
+	if err != nil {
+		return nil, translateError(err, nil)
+	}
+
+	return familyIDs, nil
+}
+
+func (r *MariaDBTokenRepository) RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	return translateError(r.DB.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&model.RevokedToken{JTI: jti, ExpiresAt: expiresAt}).
+		Error, nil)
+}
+
+func (r *MariaDBTokenRepository) IsAccessTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	var count int64
+
+	if err := r.DB.WithContext(ctx).Model(&model.RevokedToken{}).Where("jti = ?", jti).Count(&count).Error; err != nil {
+		return false, translateError(err, nil)
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,44 @@
+package mariaDB
+
+import (
+	"context"
+
+	"github.com/B-Bridger/server/repository"
+	"gorm.io/gorm"
+)
+
+// context 에 진행 중인 트랜잭션을 저장하는 키
+type txKey struct{}
+
+// MariaDBUnitOfWork는 gorm 트랜잭션으로 repository.UnitOfWork 를 구현합니다.
+type MariaDBUnitOfWork struct {
+	DB *gorm.DB
+}
+
+// NewRepositories는 db 를 사용하는 저장소 묶음을 생성합니다.
+// 트랜잭션(tx)을 전달하면 모든 저장소가 그 트랜잭션 안에서 동작합니다.
+func NewRepositories(db *gorm.DB) *repository.Repositories {
+	return &repository.Repositories{
+		Users:           &MariaDBUserRepository{DB: db},
+		ChatRooms:       &MariaDBChatRoomRepository{DB: db},
+		ChatRoomMembers: &MariaDBChatRoomMemberRepository{DB: db},
+		Messages:        &MariaDBMessageRepository{DB: db},
+		Tokens:          &MariaDBTokenRepository{DB: db},
+		Companies:       &MariaDBCompanyRepository{DB: db},
+		Glossary:        &MariaDBGlossaryRepository{DB: db},
+		Devices:         &MariaDBDeviceRepository{DB: db},
+	}
+}
+
+func (u *MariaDBUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context, repos *repository.Repositories) error) error {
+	// 이미 트랜잭션 안이면 gorm 이 SAVEPOINT 로 중첩 트랜잭션을 만듭니다.
+	db := u.DB
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		db = tx
+	}
+
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx), NewRepositories(tx))
+	})
+	return translateError(err, nil)
+}
@@ -0,0 +1,95 @@
+package mariaDB
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/repository"
+)
+
+var _ repository.UnitOfWork = (*MariaDBUnitOfWork)(nil)
+
+func TestUnitOfWork(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	uow := &MariaDBUnitOfWork{DB: db}
+	users := &MariaDBUserRepository{DB: db}
+	errFail := errors.New("fail")
+
+	t.Run("commit", func(t *testing.T) {
+		owner := &model.User{Name: "Owner", Email: "owner@example.com"}
+		err := uow.Do(ctx, func(ctx context.Context, repos *repository.Repositories) error {
+			if err := repos.Users.Create(ctx, owner); err != nil {
+				return err
+			}
+			return repos.ChatRooms.Create(ctx, &model.ChatRoom{
+				UserID:  owner.UserID,
+				Members: []model.ChatRoomMember{{UserID: owner.UserID, Role: model.ChatRoomRoleOwner}},
+			})
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		rooms, err := (&MariaDBChatRoomRepository{DB: db}).FindByMember(ctx, owner.UserID)
+		if err != nil || len(*rooms) != 1 {
+			t.Fatalf("rooms = %v, err = %v", rooms, err)
+		}
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		err := uow.Do(ctx, func(ctx context.Context, repos *repository.Repositories) error {
+			if err := repos.Users.Create(ctx, &model.User{Email: "rollback@example.com"}); err != nil {
+				return err
+			}
+			return errFail
+		})
+		if !errors.Is(err, errFail) {
+			t.Fatalf("err = %v, want %v", err, errFail)
+		}
+		if _, err := users.FindByEmail(ctx, "rollback@example.com"); !errors.Is(err, repository.ErrUserNotFound) {
+			t.Fatalf("err = %v, rollback 되지 않았습니다", err)
+		}
+	})
+
+	t.Run("nested savepoint", func(t *testing.T) {
+		err := uow.Do(ctx, func(ctx context.Context, repos *repository.Repositories) error {
+			if err := repos.Users.Create(ctx, &model.User{Email: "outer@example.com"}); err != nil {
+				return err
+			}
+			err := uow.Do(ctx, func(ctx context.Context, repos *repository.Repositories) error {
+				if err := repos.Users.Create(ctx, &model.User{Email: "inner@example.com"}); err != nil {
+					return err
+				}
+				return errFail
+			})
+			if !errors.Is(err, errFail) {
+				t.Fatalf("err = %v, want %v", err, errFail)
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := users.FindByEmail(ctx, "outer@example.com"); err != nil {
+			t.Fatalf("err = %v, commit 되지 않았습니다", err)
+		}
+		if _, err := users.FindByEmail(ctx, "inner@example.com"); !errors.Is(err, repository.ErrUserNotFound) {
+			t.Fatalf("err = %v, savepoint 가 rollback 되지 않았습니다", err)
+		}
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		func() {
+			defer func() { recover() }()
+			uow.Do(ctx, func(ctx context.Context, repos *repository.Repositories) error {
+				repos.Users.Create(ctx, &model.User{Email: "panic@example.com"})
+				panic("boom")
+			})
+		}()
+		if _, err := users.FindByEmail(ctx, "panic@example.com"); !errors.Is(err, repository.ErrUserNotFound) {
+			t.Fatalf("err = %v, rollback 되지 않았습니다", err)
+		}
+	})
+}
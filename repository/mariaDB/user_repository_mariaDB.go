@@ -1,7 +1,11 @@
 package mariaDB
 
 import (
+	"context"
+	"errors"
+
 	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/repository"
 	"gorm.io/gorm"
 )
 
@@ -9,45 +13,119 @@ type MariaDBUserRepository struct {
 	DB *gorm.DB
 }
 
-func (r *MariaDBUserRepository) FindByID(id string) (*model.User, error) {
+func (r *MariaDBUserRepository) FindByID(ctx context.Context, id string) (*model.User, error) {
 	var user model.User
 	// TODO: SQL Injection 여부 확인 필요
-	if err := r.DB.First(&user, "userID = ?", id).Error; err != nil {
-		return nil, err
+	if err := r.DB.WithContext(ctx).First(&user, "userID = ?", id).Error; err != nil {
+		return nil, translateError(err, repository.ErrUserNotFound)
 	}
 
 	return &user, nil
 }
 
-func (r *MariaDBUserRepository) FindByEmail(email string) (*model.User, error) {
+func (r *MariaDBUserRepository) FindByIDs(ctx context.Context, ids []string) ([]model.User, error) {
+	var users []model.User
+	if len(ids) == 0 {
+		return users, nil
+	}
+
+	if err := r.DB.WithContext(ctx).Find(&users, "userID IN ?", ids).Error; err != nil {
+		return nil, translateError(err, nil)
+	}
+
+	return users, nil
+}
+
+func (r *MariaDBUserRepository) FindByEmail(ctx context.Context, email string) (*model.User, error) {
 	var user model.User
 	// TODO: SQL Injection 여부 확인 필요
-	if err := r.DB.First(&user, "email = ?", email).Error; err != nil {
-		return nil, err
+	if err := r.DB.WithContext(ctx).First(&user, "email = ?", email).Error; err != nil {
+		return nil, translateError(err, repository.ErrUserNotFound)
 	}
 
 	return &user, nil
 }
 
-func (r *MariaDBUserRepository) Create(user *model.User) error {
-	return r.DB.Create(user).Error
+func (r *MariaDBUserRepository) Create(ctx context.Context, user *model.User) error {
+	return translateUserError(r.DB.WithContext(ctx).Create(user).Error)
+}
+
+func (r *MariaDBUserRepository) UpdateProfile(ctx context.Context, user *model.User) error {
+	return r.updateColumns(ctx, user.UserID, map[string]any{
+		"name":     user.Name,
+		"language": user.Language,
+		"profile":  user.Profile,
+	})
+}
+
+func (r *MariaDBUserRepository) UpdateEmail(ctx context.Context, userID, email string) error {
+	return r.updateColumns(ctx, userID, map[string]any{"email": email})
+}
+
+func (r *MariaDBUserRepository) UpdatePassword(ctx context.Context, userID, passwordHash string) error {
+	return r.updateColumns(ctx, userID, map[string]any{"password": passwordHash})
 }
 
-func (r *MariaDBUserRepository) Update(user *model.User) (*model.User, error) {
-	if err := r.DB.Save(user).Error; err != nil {
-		return nil, err
+// updateColumns는 사용자의 주어진 열만 변경합니다.
+// 구조체와 달리 map 으로 지정한 열은 빈 값이어도 저장됩니다.
+func (r *MariaDBUserRepository) updateColumns(ctx context.Context, userID string, columns map[string]any) error {
+	result := r.DB.WithContext(ctx).Model(&model.User{}).Where("userID = ?", userID).Updates(columns)
+	if result.Error != nil {
+		return translateUserError(result.Error)
 	}
-	return user, nil
+	// MySQL 은 값이 바뀐 행만 세므로, 0 이면 사용자가 있는지 다시 확인합니다.
+	if result.RowsAffected == 0 {
+		var count int64
+		if err := r.DB.WithContext(ctx).Model(&model.User{}).Where("userID = ?", userID).Count(&count).Error; err != nil {
+			return translateError(err, nil)
+		}
+		if count == 0 {
+			return repository.ErrUserNotFound
+		}
+	}
+	return nil
 }
 
-func (r *MariaDBUserRepository) Delete(id string) error {
+func (r *MariaDBUserRepository) Delete(ctx context.Context, id string) error {
 	// ToDO: SQL Injection 여부 확인 필요
-	return r.DB.Delete(&model.User{}, "userID = ?", id).Error
+	return translateError(r.DB.WithContext(ctx).Delete(&model.User{}, "userID = ?", id).Error, nil)
 }
 
-func (r *MariaDBUserRepository) UpdateProfileImage(userID string, imageURL string) error {
-	return r.DB.Model(&model.User{}).
+func (r *MariaDBUserRepository) UpdateProfileImage(ctx context.Context, userID string, imageURL string) error {
+	return translateError(r.DB.WithContext(ctx).Model(&model.User{}).
 		Where("userID = ?", userID).
 		Update("profile", imageURL).
-		Error
+		Error, nil)
+}
+
+func (r *MariaDBUserRepository) CreateEmailChange(ctx context.Context, change *model.EmailChange) error {
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&model.EmailChange{}, "userID = ?", change.UserID).Error; err != nil {
+			return err
+		}
+		return tx.Create(change).Error
+	})
+	return translateError(err, nil)
+}
+
+func (r *MariaDBUserRepository) FindEmailChangeByHash(ctx context.Context, hash string) (*model.EmailChange, error) {
+	var change model.EmailChange
+	if err := r.DB.WithContext(ctx).First(&change, "tokenHash = ?", hash).Error; err != nil {
+		return nil, translateError(err, repository.ErrEmailChangeNotFound)
+	}
+
+	return &change, nil
+}
+
+func (r *MariaDBUserRepository) DeleteEmailChanges(ctx context.Context, userID string) error {
+	return translateError(r.DB.WithContext(ctx).Delete(&model.EmailChange{}, "userID = ?", userID).Error, nil)
+}
+
+// translateUserError는 users 테이블의 unique 제약 위반을 ErrEmailTaken 으로 변환합니다.
+// userID 는 uuid 로 생성되므로 충돌하는 unique 열은 email 뿐입니다.
+func translateUserError(err error) error {
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return repository.ErrEmailTaken
+	}
+	return translateError(err, nil)
 }
@@ -0,0 +1,56 @@
+package mariaDB
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/repository"
+)
+
+// 사용자 정보 수정은 지정한 열만 저장하고, 비밀번호 해시 등 나머지 열은 유지해야 합니다.
+func TestUserPartialUpdates(t *testing.T) {
+	ctx := context.Background()
+	repo := &MariaDBUserRepository{DB: newTestDB(t)}
+
+	alice := &model.User{Name: "Alice", Email: "alice@example.com", Password: "old-hash", Language: "ko"}
+	bob := &model.User{Name: "Bob", Email: "bob@example.com"}
+	for _, u := range []*model.User{alice, bob} {
+		if err := repo.Create(ctx, u); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// 비밀번호를 읽기 전의 값으로 프로필을 저장해도 새 비밀번호가 유지됩니다.
+	stale := *alice
+	if err := repo.UpdatePassword(ctx, alice.UserID, "new-hash"); err != nil {
+		t.Fatal(err)
+	}
+	stale.Name = "Alice Kim"
+	stale.Language = ""
+	if err := repo.UpdateProfile(ctx, &stale); err != nil {
+		t.Fatal(err)
+	}
+	found, err := repo.FindByID(ctx, alice.UserID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found.Password != "new-hash" || found.Name != "Alice Kim" || found.Language != "" || found.Email != "alice@example.com" {
+		t.Fatalf("user = %+v", found)
+	}
+
+	if err := repo.UpdateEmail(ctx, bob.UserID, "alice@example.com"); !errors.Is(err, repository.ErrEmailTaken) {
+		t.Fatalf("err = %v, want ErrEmailTaken", err)
+	}
+	if err := repo.UpdateEmail(ctx, bob.UserID, "robert@example.com"); err != nil {
+		t.Fatal(err)
+	}
+	// 값이 바뀌지 않아도 성공하며, 없는 사용자는 ErrUserNotFound 를 반환합니다.
+	if err := repo.UpdateEmail(ctx, bob.UserID, "robert@example.com"); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.UpdatePassword(ctx, "unknown", "hash"); !errors.Is(err, repository.ErrUserNotFound) {
+		t.Fatalf("err = %v, want ErrUserNotFound", err)
+	}
+}
@@ -0,0 +1,95 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/repository"
+)
+
+type MemoryChatRoomMemberRepository struct {
+	Store *Store
+}
+
+func (r *MemoryChatRoomMemberRepository) FindMember(ctx context.Context, chatRoomID, userID string) (*model.ChatRoomMember, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.Store.mu.RLock()
+	defer r.Store.mu.RUnlock()
+
+	member, ok := r.Store.members[pairKey{chatRoomID, userID}]
+	if !ok {
+		return nil, repository.ErrChatRoomMemberNotFound
+	}
+	member.User = r.Store.users[userID]
+	return &member, nil
+}
+
+func (r *MemoryChatRoomMemberRepository) FindMembers(ctx context.Context, chatRoomID string) ([]model.ChatRoomMember, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.Store.mu.RLock()
+	defer r.Store.mu.RUnlock()
+
+	return r.Store.chatRoomMembers(chatRoomID), nil
+}
+
+func (r *MemoryChatRoomMemberRepository) AddMembers(ctx context.Context, members []model.ChatRoomMember) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	now := time.Now()
+	for i := range members {
+		m := &members[i]
+		if m.JoinedAt.IsZero() {
+			m.JoinedAt = now
+		}
+		key := pairKey{m.ChatRoomID, m.UserID}
+		if _, ok := r.Store.members[key]; ok {
+			continue
+		}
+		stored := *m
+		stored.User = model.User{}
+		r.Store.members[key] = stored
+	}
+	return nil
+}
+
+func (r *MemoryChatRoomMemberRepository) RemoveMembers(ctx context.Context, chatRoomID string, userIDs []string) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	for _, userID := range userIDs {
+		delete(r.Store.members, pairKey{chatRoomID, userID})
+	}
+	return nil
+}
+
+func (r *MemoryChatRoomMemberRepository) SetMuted(ctx context.Context, chatRoomID, userID string, muted bool) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	key := pairKey{chatRoomID, userID}
+	if member, ok := r.Store.members[key]; ok {
+		member.Muted = muted
+		r.Store.members[key] = member
+	}
+	return nil
+}
@@ -0,0 +1,263 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/repository"
+)
+
+type MemoryChatRoomRepository struct {
+	Store *Store
+}
+
+func (r *MemoryChatRoomRepository) FindByID(ctx context.Context, id string) (*model.ChatRoom, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.Store.mu.RLock()
+	defer r.Store.mu.RUnlock()
+
+	stored, ok := r.Store.chatRooms[id]
+	if !ok {
+		return nil, repository.ErrChatRoomNotFound
+	}
+
+	chatRoom := r.Store.withOwner(stored)
+	chatRoom.Members = r.Store.chatRoomMembers(id)
+	chatRoom.Companies = make([]model.ChatRoomCompany, 0)
+	for key, c := range r.Store.chatRoomCompanies {
+		if key.a != id {
+			continue
+		}
+		c.Company = r.Store.companies[c.CompanyID]
+		chatRoom.Companies = append(chatRoom.Companies, c)
+	}
+	sort.Slice(chatRoom.Companies, func(i, j int) bool {
+		return chatRoom.Companies[i].CompanyID < chatRoom.Companies[j].CompanyID
+	})
+
+	return &chatRoom, nil
+}
+
+func (r *MemoryChatRoomRepository) FindByOwner(ctx context.Context, id string) (*[]model.ChatRoom, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.Store.mu.RLock()
+	defer r.Store.mu.RUnlock()
+
+	chatRooms := make([]model.ChatRoom, 0)
+	for _, chatRoom := range r.Store.chatRooms {
+		if chatRoom.UserID == id {
+			chatRooms = append(chatRooms, r.Store.withOwner(chatRoom))
+		}
+	}
+	sort.Slice(chatRooms, func(i, j int) bool {
+		return chatRooms[i].ChatRoomID < chatRooms[j].ChatRoomID
+	})
+
+	return &chatRooms, nil
+}
+
+func (r *MemoryChatRoomRepository) FindByMember(ctx context.Context, id string) (*[]model.ChatRoom, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.Store.mu.RLock()
+	defer r.Store.mu.RUnlock()
+
+	chatRooms := make([]model.ChatRoom, 0)
+	for key := range r.Store.members {
+		if key.b != id {
+			continue
+		}
+		if chatRoom, ok := r.Store.chatRooms[key.a]; ok {
+			chatRooms = append(chatRooms, r.Store.withOwner(chatRoom))
+		}
+	}
+	sort.Slice(chatRooms, func(i, j int) bool {
+		if chatRooms[i].LastMessageAt.Equal(chatRooms[j].LastMessageAt) {
+			return chatRooms[i].ChatRoomID < chatRooms[j].ChatRoomID
+		}
+		return chatRooms[i].LastMessageAt.After(chatRooms[j].LastMessageAt)
+	})
+
+	return &chatRooms, nil
+}
+
+func (r *MemoryChatRoomRepository) FindParticipants(ctx context.Context, id string) ([]model.User, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.Store.mu.RLock()
+	defer r.Store.mu.RUnlock()
+
+	users := make([]model.User, 0)
+	for _, m := range r.Store.chatRoomMembers(id) {
+		if _, ok := r.Store.users[m.UserID]; ok {
+			users = append(users, m.User)
+		}
+	}
+
+	return users, nil
+}
+
+func (r *MemoryChatRoomRepository) Create(ctx context.Context, chatRoom *model.ChatRoom) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	if err := chatRoom.BeforeCreate(nil); err != nil {
+		return err
+	}
+	if _, ok := r.Store.chatRooms[chatRoom.ChatRoomID]; ok {
+		return repository.ErrDuplicate
+	}
+	now := time.Now()
+	if chatRoom.CreatedAt.IsZero() {
+		chatRoom.CreatedAt = now
+	}
+
+	// gorm 과 같이 Members, Companies 연관 레코드도 함께 저장하며, 이미 있는 레코드는 건너뜁니다.
+	for i := range chatRoom.Members {
+		m := &chatRoom.Members[i]
+		m.ChatRoomID = chatRoom.ChatRoomID
+		if m.JoinedAt.IsZero() {
+			m.JoinedAt = now
+		}
+		key := pairKey{m.ChatRoomID, m.UserID}
+		if _, ok := r.Store.members[key]; !ok {
+			stored := *m
+			stored.User = model.User{}
+			r.Store.members[key] = stored
+		}
+	}
+	for i := range chatRoom.Companies {
+		c := &chatRoom.Companies[i]
+		c.ChatRoomID = chatRoom.ChatRoomID
+		if c.JoinedAt.IsZero() {
+			c.JoinedAt = now
+		}
+		key := pairKey{c.ChatRoomID, c.CompanyID}
+		if _, ok := r.Store.chatRoomCompanies[key]; !ok {
+			stored := *c
+			stored.Company = model.Company{}
+			r.Store.chatRoomCompanies[key] = stored
+		}
+	}
+
+	r.Store.chatRooms[chatRoom.ChatRoomID] = r.Store.withOwner(*chatRoom)
+	return nil
+}
+
+func (r *MemoryChatRoomRepository) AddCompanies(ctx context.Context, id string, companyIDs []string) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	now := time.Now()
+	for _, companyID := range companyIDs {
+		key := pairKey{id, companyID}
+		if _, ok := r.Store.chatRoomCompanies[key]; ok {
+			continue
+		}
+		r.Store.chatRoomCompanies[key] = model.ChatRoomCompany{ChatRoomID: id, CompanyID: companyID, JoinedAt: now}
+	}
+	return nil
+}
+
+func (r *MemoryChatRoomRepository) UpdateSettings(ctx context.Context, chatRoom *model.ChatRoom) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	stored, ok := r.Store.chatRooms[chatRoom.ChatRoomID]
+	if !ok {
+		return repository.ErrChatRoomNotFound
+	}
+	stored.Title = chatRoom.Title
+	stored.Description = chatRoom.Description
+	stored.DefaultLanguage = chatRoom.DefaultLanguage
+	stored.Avatar = chatRoom.Avatar
+	r.Store.chatRooms[chatRoom.ChatRoomID] = stored
+	return nil
+}
+
+func (r *MemoryChatRoomRepository) TransferOwnership(ctx context.Context, id, userID string) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	chatRoom, ok := r.Store.chatRooms[id]
+	if !ok {
+		return repository.ErrChatRoomNotFound
+	}
+	if chatRoom.UserID == userID {
+		return nil
+	}
+	member, ok := r.Store.members[pairKey{id, userID}]
+	if !ok {
+		return repository.ErrChatRoomMemberNotFound
+	}
+	member.Role = model.ChatRoomRoleOwner
+	r.Store.members[pairKey{id, userID}] = member
+	if previous, ok := r.Store.members[pairKey{id, chatRoom.UserID}]; ok {
+		previous.Role = model.ChatRoomRoleAdmin
+		r.Store.members[pairKey{id, chatRoom.UserID}] = previous
+	}
+	chatRoom.UserID = userID
+	r.Store.chatRooms[id] = chatRoom
+	return nil
+}
+
+func (r *MemoryChatRoomRepository) Delete(ctx context.Context, id string) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	for messageID, m := range r.Store.messages {
+		if m.ChatRoomID == id {
+			delete(r.Store.translations, messageID)
+			delete(r.Store.messages, messageID)
+		}
+	}
+	for key := range r.Store.members {
+		if key.a == id {
+			delete(r.Store.members, key)
+		}
+	}
+	for key := range r.Store.chatRoomCompanies {
+		if key.a == id {
+			delete(r.Store.chatRoomCompanies, key)
+		}
+	}
+	for termID, term := range r.Store.glossaryTerms {
+		if term.Scope == model.GlossaryScopeChatRoom && term.ScopeID == id {
+			delete(r.Store.glossaryTerms, termID)
+		}
+	}
+	delete(r.Store.chatRooms, id)
+	return nil
+}
@@ -0,0 +1,217 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/repository"
+)
+
+type MemoryCompanyRepository struct {
+	Store *Store
+}
+
+func (r *MemoryCompanyRepository) FindByID(ctx context.Context, id string) (*model.Company, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.Store.mu.RLock()
+	defer r.Store.mu.RUnlock()
+
+	company, ok := r.Store.companies[id]
+	if !ok {
+		return nil, repository.ErrCompanyNotFound
+	}
+	return &company, nil
+}
+
+func (r *MemoryCompanyRepository) Create(ctx context.Context, company *model.Company, adminUserID string) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	if err := company.BeforeCreate(nil); err != nil {
+		return err
+	}
+	if _, ok := r.Store.companies[company.CompanyID]; ok {
+		return repository.ErrDuplicate
+	}
+	if company.CreatedAt.IsZero() {
+		company.CreatedAt = time.Now()
+	}
+
+	r.Store.companies[company.CompanyID] = *company
+	if user, ok := r.Store.users[adminUserID]; ok {
+		user.CompanyID = company.CompanyID
+		user.CompanyRole = model.CompanyRoleAdmin
+		r.Store.users[adminUserID] = user
+	}
+	return nil
+}
+
+func (r *MemoryCompanyRepository) Update(ctx context.Context, company *model.Company) (*model.Company, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	r.Store.companies[company.CompanyID] = *company
+	return company, nil
+}
+
+func (r *MemoryCompanyRepository) FindUsers(ctx context.Context, id string, query string) ([]model.User, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.Store.mu.RLock()
+	defer r.Store.mu.RUnlock()
+
+	// MariaDB 의 기본 collation 과 같이 대소문자를 구분하지 않고 검색합니다.
+	query = strings.ToLower(query)
+	users := make([]model.User, 0)
+	for _, user := range r.Store.users {
+		if user.CompanyID != id {
+			continue
+		}
+		if query != "" &&
+			!strings.Contains(strings.ToLower(user.Name), query) &&
+			!strings.Contains(strings.ToLower(user.Email), query) {
+			continue
+		}
+		users = append(users, user)
+	}
+	sort.Slice(users, func(i, j int) bool {
+		if users[i].Name == users[j].Name {
+			return users[i].UserID < users[j].UserID
+		}
+		return users[i].Name < users[j].Name
+	})
+
+	return users, nil
+}
+
+// LockAdmins는 관리자 목록을 반환합니다. 트랜잭션은 Store.txMu 로 직렬화되므로 따로 잠그지 않습니다.
+func (r *MemoryCompanyRepository) LockAdmins(ctx context.Context, id string) ([]string, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.Store.mu.RLock()
+	defer r.Store.mu.RUnlock()
+
+	var userIDs []string
+	for _, user := range r.Store.users {
+		if user.CompanyID == id && user.CompanyRole == model.CompanyRoleAdmin {
+			userIDs = append(userIDs, user.UserID)
+		}
+	}
+	sort.Strings(userIDs)
+	return userIDs, nil
+}
+
+func (r *MemoryCompanyRepository) SetMembership(ctx context.Context, userID, companyID, role string) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	if user, ok := r.Store.users[userID]; ok {
+		user.CompanyID = companyID
+		user.CompanyRole = role
+		r.Store.users[userID] = user
+	}
+	return nil
+}
+
+func (r *MemoryCompanyRepository) CreateInvitation(ctx context.Context, invitation *model.CompanyInvitation) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	if err := invitation.BeforeCreate(nil); err != nil {
+		return err
+	}
+	for id, i := range r.Store.companyInvitations {
+		// MariaDB 의 기본 collation 과 같이 이메일의 대소문자를 구분하지 않습니다.
+		if id == invitation.InvitationID || i.CompanyID == invitation.CompanyID && strings.EqualFold(i.Email, invitation.Email) {
+			return repository.ErrDuplicate
+		}
+	}
+	if invitation.CreatedAt.IsZero() {
+		invitation.CreatedAt = time.Now()
+	}
+
+	stored := *invitation
+	stored.Company = model.Company{}
+	r.Store.companyInvitations[invitation.InvitationID] = stored
+	return nil
+}
+
+func (r *MemoryCompanyRepository) FindInvitation(ctx context.Context, id string) (*model.CompanyInvitation, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.Store.mu.RLock()
+	defer r.Store.mu.RUnlock()
+
+	invitation, ok := r.Store.companyInvitations[id]
+	if !ok {
+		return nil, repository.ErrCompanyInvitationNotFound
+	}
+	invitation.Company = r.Store.companies[invitation.CompanyID]
+	return &invitation, nil
+}
+
+func (r *MemoryCompanyRepository) FindInvitationsByEmail(ctx context.Context, email string) ([]model.CompanyInvitation, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.Store.mu.RLock()
+	defer r.Store.mu.RUnlock()
+
+	invitations := make([]model.CompanyInvitation, 0)
+	for _, invitation := range r.Store.companyInvitations {
+		if !strings.EqualFold(invitation.Email, email) {
+			continue
+		}
+		invitation.Company = r.Store.companies[invitation.CompanyID]
+		invitations = append(invitations, invitation)
+	}
+	sort.Slice(invitations, func(i, j int) bool {
+		if invitations[i].CreatedAt.Equal(invitations[j].CreatedAt) {
+			return invitations[i].InvitationID < invitations[j].InvitationID
+		}
+		return invitations[i].CreatedAt.Before(invitations[j].CreatedAt)
+	})
+
+	return invitations, nil
+}
+
+func (r *MemoryCompanyRepository) DeleteInvitation(ctx context.Context, id string) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	delete(r.Store.companyInvitations, id)
+	return nil
+}
@@ -0,0 +1,140 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/B-Bridger/server/model"
+)
+
+type MemoryDeviceRepository struct {
+	Store *Store
+}
+
+func (r *MemoryDeviceRepository) FindByUser(ctx context.Context, userID string) ([]model.Device, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.Store.mu.RLock()
+	defer r.Store.mu.RUnlock()
+
+	devices := make([]model.Device, 0)
+	for _, d := range r.Store.devices {
+		if d.UserID == userID {
+			devices = append(devices, d)
+		}
+	}
+	sort.Slice(devices, func(i, j int) bool {
+		return devices[i].LastSeenAt.After(devices[j].LastSeenAt)
+	})
+
+	return devices, nil
+}
+
+func (r *MemoryDeviceRepository) FindActiveByUsers(ctx context.Context, userIDs []string, since time.Time) ([]model.Device, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.Store.mu.RLock()
+	defer r.Store.mu.RUnlock()
+
+	ids := make(map[string]struct{}, len(userIDs))
+	for _, id := range userIDs {
+		ids[id] = struct{}{}
+	}
+
+	devices := make([]model.Device, 0)
+	for _, d := range r.Store.devices {
+		if _, ok := ids[d.UserID]; ok && !d.LastSeenAt.Before(since) {
+			devices = append(devices, d)
+		}
+	}
+	sort.Slice(devices, func(i, j int) bool {
+		return devices[i].DeviceID < devices[j].DeviceID
+	})
+
+	return devices, nil
+}
+
+func (r *MemoryDeviceRepository) Upsert(ctx context.Context, device *model.Device) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	for _, existing := range r.Store.devices {
+		if existing.PushToken != device.PushToken {
+			continue
+		}
+		device.DeviceID = existing.DeviceID
+		device.CreatedAt = existing.CreatedAt
+		r.Store.devices[device.DeviceID] = *device
+		return nil
+	}
+
+	if err := device.BeforeCreate(nil); err != nil {
+		return err
+	}
+	if device.CreatedAt.IsZero() {
+		device.CreatedAt = time.Now()
+	}
+	r.Store.devices[device.DeviceID] = *device
+	return nil
+}
+
+func (r *MemoryDeviceRepository) Delete(ctx context.Context, userID, deviceID string) (bool, error) {
+	if err := checkContext(ctx); err != nil {
+		return false, err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	d, ok := r.Store.devices[deviceID]
+	if !ok || d.UserID != userID {
+		return false, nil
+	}
+	delete(r.Store.devices, deviceID)
+	return true, nil
+}
+
+func (r *MemoryDeviceRepository) DeleteBySession(ctx context.Context, sessionID string) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	return r.deleteWhere(func(d model.Device) bool { return d.SessionID == sessionID })
+}
+
+func (r *MemoryDeviceRepository) DeleteByToken(ctx context.Context, pushToken string) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	return r.deleteWhere(func(d model.Device) bool { return d.PushToken == pushToken })
+}
+
+func (r *MemoryDeviceRepository) DeleteByUser(ctx context.Context, userID string) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	return r.deleteWhere(func(d model.Device) bool { return d.UserID == userID })
+}
+
+func (r *MemoryDeviceRepository) deleteWhere(match func(model.Device) bool) error {
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	for deviceID, d := range r.Store.devices {
+		if match(d) {
+			delete(r.Store.devices, deviceID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/repository"
+)
+
+type MemoryGlossaryRepository struct {
+	Store *Store
+}
+
+func (r *MemoryGlossaryRepository) FindTermByID(ctx context.Context, id string) (*model.GlossaryTerm, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.Store.mu.RLock()
+	defer r.Store.mu.RUnlock()
+
+	term, ok := r.Store.glossaryTerms[id]
+	if !ok {
+		return nil, repository.ErrGlossaryTermNotFound
+	}
+	term = copyTerm(term)
+	return &term, nil
+}
+
+func (r *MemoryGlossaryRepository) FindTerms(ctx context.Context, scope string, scopeIDs []string) ([]model.GlossaryTerm, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.Store.mu.RLock()
+	defer r.Store.mu.RUnlock()
+
+	ids := make(map[string]struct{}, len(scopeIDs))
+	for _, id := range scopeIDs {
+		ids[id] = struct{}{}
+	}
+
+	terms := make([]model.GlossaryTerm, 0)
+	for _, term := range r.Store.glossaryTerms {
+		if term.Scope != scope {
+			continue
+		}
+		if _, ok := ids[term.ScopeID]; !ok {
+			continue
+		}
+		terms = append(terms, copyTerm(term))
+	}
+	sort.Slice(terms, func(i, j int) bool {
+		if terms[i].Source == terms[j].Source {
+			return terms[i].TermID < terms[j].TermID
+		}
+		return terms[i].Source < terms[j].Source
+	})
+
+	return terms, nil
+}
+
+func (r *MemoryGlossaryRepository) CreateTerm(ctx context.Context, term *model.GlossaryTerm) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	return r.createTerm(term)
+}
+
+func (r *MemoryGlossaryRepository) UpdateTerm(ctx context.Context, term *model.GlossaryTerm) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	return r.replaceTerm(term)
+}
+
+func (r *MemoryGlossaryRepository) DeleteTerm(ctx context.Context, id string) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	delete(r.Store.glossaryTerms, id)
+	return nil
+}
+
+func (r *MemoryGlossaryRepository) UpsertTerms(ctx context.Context, terms []model.GlossaryTerm) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	// 하나라도 실패하면 아무것도 반영하지 않도록 원래 상태를 보관해 둡니다.
+	backup := make(map[string]model.GlossaryTerm, len(r.Store.glossaryTerms))
+	for id, term := range r.Store.glossaryTerms {
+		backup[id] = term
+	}
+
+	for i := range terms {
+		term := &terms[i]
+
+		var err error
+		if existing := r.findBySource(term.Scope, term.ScopeID, term.Source); existing != nil {
+			term.TermID = existing.TermID
+			err = r.replaceTerm(term)
+		} else {
+			err = r.createTerm(term)
+		}
+		if err != nil {
+			r.Store.glossaryTerms = backup
+			return err
+		}
+	}
+	return nil
+}
+
+// 아래 함수들은 r.Store.mu 를 잡은 상태에서 호출해야 합니다.
+
+// findBySource는 scope, scopeID 안에서 원문이 같은 용어를 찾습니다.
+func (r *MemoryGlossaryRepository) findBySource(scope, scopeID, source string) *model.GlossaryTerm {
+	for _, term := range r.Store.glossaryTerms {
+		if term.Scope == scope && term.ScopeID == scopeID && term.Source == source {
+			return &term
+		}
+	}
+	return nil
+}
+
+func (r *MemoryGlossaryRepository) createTerm(term *model.GlossaryTerm) error {
+	if err := term.BeforeCreate(nil); err != nil {
+		return err
+	}
+	if _, ok := r.Store.glossaryTerms[term.TermID]; ok {
+		return repository.ErrDuplicate
+	}
+	if r.findBySource(term.Scope, term.ScopeID, term.Source) != nil {
+		return repository.ErrDuplicate
+	}
+	now := time.Now()
+	if term.CreatedAt.IsZero() {
+		term.CreatedAt = now
+	}
+	term.UpdatedAt = now
+	for i := range term.Targets {
+		term.Targets[i].TermID = term.TermID
+	}
+
+	r.Store.glossaryTerms[term.TermID] = copyTerm(*term)
+	return nil
+}
+
+// replaceTerm은 용어 정보를 수정하고 번역어 목록을 교체합니다.
+func (r *MemoryGlossaryRepository) replaceTerm(term *model.GlossaryTerm) error {
+	stored, ok := r.Store.glossaryTerms[term.TermID]
+	if !ok {
+		return nil
+	}
+	if existing := r.findBySource(stored.Scope, stored.ScopeID, term.Source); existing != nil && existing.TermID != term.TermID {
+		return repository.ErrDuplicate
+	}
+
+	stored.Source = term.Source
+	stored.CaseSensitive = term.CaseSensitive
+	stored.DoNotTranslate = term.DoNotTranslate
+	stored.UpdatedAt = time.Now()
+	for i := range term.Targets {
+		term.Targets[i].TermID = term.TermID
+	}
+	stored.Targets = term.Targets
+
+	r.Store.glossaryTerms[term.TermID] = copyTerm(stored)
+	return nil
+}
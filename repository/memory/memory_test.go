@@ -0,0 +1,266 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/repository"
+)
+
+var (
+	_ repository.UserRepository           = (*MemoryUserRepository)(nil)
+	_ repository.ChatRoomRepository       = (*MemoryChatRoomRepository)(nil)
+	_ repository.ChatRoomMemberRepository = (*MemoryChatRoomMemberRepository)(nil)
+	_ repository.MessageRepository        = (*MemoryMessageRepository)(nil)
+	_ repository.TokenRepository          = (*MemoryTokenRepository)(nil)
+	_ repository.CompanyRepository        = (*MemoryCompanyRepository)(nil)
+	_ repository.GlossaryRepository       = (*MemoryGlossaryRepository)(nil)
+	_ repository.DeviceRepository         = (*MemoryDeviceRepository)(nil)
+	_ repository.UnitOfWork               = (*MemoryUnitOfWork)(nil)
+)
+
+func TestUserRepository(t *testing.T) {
+	ctx := context.Background()
+	repo := &MemoryUserRepository{Store: NewStore()}
+
+	alice := &model.User{Name: "Alice", Email: "alice@example.com"}
+	if err := repo.Create(ctx, alice); err != nil {
+		t.Fatal(err)
+	}
+	if alice.UserID == "" || alice.CreatedAt.IsZero() {
+		t.Fatalf("user = %+v", alice)
+	}
+
+	if err := repo.Create(ctx, &model.User{Email: "alice@example.com"}); !errors.Is(err, repository.ErrEmailTaken) {
+		t.Fatalf("err = %v, want ErrDuplicatedKey", err)
+	}
+	if _, err := repo.FindByID(ctx, "unknown"); !errors.Is(err, repository.ErrUserNotFound) {
+		t.Fatalf("err = %v, want ErrRecordNotFound", err)
+	}
+
+	bob := &model.User{Name: "Bob", Email: "bob@example.com"}
+	if err := repo.Create(ctx, bob); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.UpdateEmail(ctx, bob.UserID, "alice@example.com"); !errors.Is(err, repository.ErrEmailTaken) {
+		t.Fatalf("err = %v, want ErrDuplicatedKey", err)
+	}
+
+	// 수정한 열 외의 필드는 그대로 유지됩니다.
+	bob.Password = "hash"
+	if err := repo.UpdatePassword(ctx, bob.UserID, bob.Password); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.UpdateProfile(ctx, &model.User{UserID: bob.UserID, Name: "Robert"}); err != nil {
+		t.Fatal(err)
+	}
+	if stored, _ := repo.FindByID(ctx, bob.UserID); stored.Name != "Robert" || stored.Password != "hash" || stored.Email != "bob@example.com" {
+		t.Fatalf("user = %+v", stored)
+	}
+	if err := repo.UpdateProfile(ctx, &model.User{UserID: "unknown"}); !errors.Is(err, repository.ErrUserNotFound) {
+		t.Fatalf("err = %v, want ErrUserNotFound", err)
+	}
+
+	// 반환된 값을 수정해도 저장된 값은 바뀌지 않습니다.
+	found, err := repo.FindByEmail(ctx, "alice@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	found.Name = "changed"
+	if stored, _ := repo.FindByID(ctx, alice.UserID); stored.Name != "Alice" {
+		t.Fatalf("name = %q, want %q", stored.Name, "Alice")
+	}
+}
+
+func TestChatRoomRepository(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore()
+	users := &MemoryUserRepository{Store: store}
+	chatRooms := &MemoryChatRoomRepository{Store: store}
+	messages := &MemoryMessageRepository{Store: store}
+
+	owner := &model.User{Name: "Owner", Email: "owner@example.com"}
+	if err := users.Create(ctx, owner); err != nil {
+		t.Fatal(err)
+	}
+	chatRoom := &model.ChatRoom{
+		UserID:  owner.UserID,
+		Members: []model.ChatRoomMember{{UserID: owner.UserID, Role: model.ChatRoomRoleOwner}},
+	}
+	if err := chatRooms.Create(ctx, chatRoom); err != nil {
+		t.Fatal(err)
+	}
+
+	found, err := chatRooms.FindByID(ctx, chatRoom.ChatRoomID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found.Owner.Email != owner.Email {
+		t.Fatalf("owner = %+v", found.Owner)
+	}
+	if len(found.Members) != 1 || found.Members[0].User.UserID != owner.UserID {
+		t.Fatalf("members = %+v", found.Members)
+	}
+
+	// createdAt 이 같은 메세지는 messageID 순서로 정렬됩니다.
+	createdAt := time.Now()
+	for _, id := range []string{"a", "b", "c"} {
+		if err := messages.Create(ctx, &model.Message{MessageID: id, ChatRoomID: chatRoom.ChatRoomID, UserID: owner.UserID, Content: id, CreatedAt: createdAt}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	page, err := messages.FindByChatRoom(ctx, chatRoom.ChatRoomID, "c", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page) != 2 || page[0].MessageID != "b" || page[1].MessageID != "a" || page[0].Sender.UserID != owner.UserID {
+		t.Fatalf("page = %+v", page)
+	}
+	if _, err := messages.FindByChatRoom(ctx, chatRoom.ChatRoomID, "unknown", 10); !errors.Is(err, repository.ErrMessageNotFound) {
+		t.Fatalf("err = %v, want ErrRecordNotFound", err)
+	}
+
+	if err := chatRooms.Delete(ctx, chatRoom.ChatRoomID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := chatRooms.FindByID(ctx, chatRoom.ChatRoomID); !errors.Is(err, repository.ErrChatRoomNotFound) {
+		t.Fatalf("err = %v, want ErrRecordNotFound", err)
+	}
+	if _, err := messages.FindByID(ctx, "a"); !errors.Is(err, repository.ErrMessageNotFound) {
+		t.Fatalf("err = %v, want ErrRecordNotFound", err)
+	}
+}
+
+func TestCanceledContext(t *testing.T) {
+	repo := &MemoryUserRepository{Store: NewStore()}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := repo.Create(ctx, &model.User{Email: "alice@example.com"}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+	if _, err := repo.FindByEmail(context.Background(), "alice@example.com"); !errors.Is(err, repository.ErrUserNotFound) {
+		t.Fatalf("err = %v, 취소된 요청의 사용자가 저장되었습니다", err)
+	}
+}
+
+func TestUnitOfWork(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore()
+	uow := &MemoryUnitOfWork{Store: store}
+	users := &MemoryUserRepository{Store: store}
+	errFail := errors.New("fail")
+
+	t.Run("rollback", func(t *testing.T) {
+		err := uow.Do(ctx, func(ctx context.Context, repos *repository.Repositories) error {
+			if err := repos.Users.Create(ctx, &model.User{Email: "rollback@example.com"}); err != nil {
+				return err
+			}
+			// commit 전에는 트랜잭션 밖에서 보이지 않습니다.
+			if _, err := users.FindByEmail(context.Background(), "rollback@example.com"); !errors.Is(err, repository.ErrUserNotFound) {
+				t.Fatalf("err = %v, want ErrUserNotFound", err)
+			}
+			return errFail
+		})
+		if !errors.Is(err, errFail) {
+			t.Fatalf("err = %v, want %v", err, errFail)
+		}
+		if _, err := users.FindByEmail(ctx, "rollback@example.com"); !errors.Is(err, repository.ErrUserNotFound) {
+			t.Fatalf("err = %v, rollback 되지 않았습니다", err)
+		}
+	})
+
+	t.Run("nested savepoint", func(t *testing.T) {
+		err := uow.Do(ctx, func(ctx context.Context, repos *repository.Repositories) error {
+			if err := repos.Users.Create(ctx, &model.User{Email: "outer@example.com"}); err != nil {
+				return err
+			}
+			err := uow.Do(ctx, func(ctx context.Context, repos *repository.Repositories) error {
+				if err := repos.Users.Create(ctx, &model.User{Email: "inner@example.com"}); err != nil {
+					return err
+				}
+				return errFail
+			})
+			if !errors.Is(err, errFail) {
+				t.Fatalf("err = %v, want %v", err, errFail)
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := users.FindByEmail(ctx, "outer@example.com"); err != nil {
+			t.Fatalf("err = %v, commit 되지 않았습니다", err)
+		}
+		if _, err := users.FindByEmail(ctx, "inner@example.com"); !errors.Is(err, repository.ErrUserNotFound) {
+			t.Fatalf("err = %v, savepoint 가 rollback 되지 않았습니다", err)
+		}
+	})
+
+	t.Run("write outside transaction", func(t *testing.T) {
+		err := uow.Do(ctx, func(ctx context.Context, repos *repository.Repositories) error {
+			if err := repos.Users.Create(ctx, &model.User{Email: "inside@example.com"}); err != nil {
+				return err
+			}
+			// 트랜잭션 중에 트랜잭션 밖에서 저장한 레코드는 commit 후에도 남아야 합니다.
+			return users.Create(context.Background(), &model.User{Email: "outside@example.com"})
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, email := range []string{"inside@example.com", "outside@example.com"} {
+			if _, err := users.FindByEmail(ctx, email); err != nil {
+				t.Fatalf("%s: err = %v", email, err)
+			}
+		}
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		func() {
+			defer func() { recover() }()
+			uow.Do(ctx, func(ctx context.Context, repos *repository.Repositories) error {
+				repos.Users.Create(ctx, &model.User{Email: "panic@example.com"})
+				panic("boom")
+			})
+		}()
+		if _, err := users.FindByEmail(ctx, "panic@example.com"); !errors.Is(err, repository.ErrUserNotFound) {
+			t.Fatalf("err = %v, rollback 되지 않았습니다", err)
+		}
+		// panic 이후에도 다음 트랜잭션을 실행할 수 있어야 합니다.
+		if err := uow.Do(ctx, func(context.Context, *repository.Repositories) error { return nil }); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestEmailChanges(t *testing.T) {
+	ctx := context.Background()
+	repo := &MemoryUserRepository{Store: NewStore()}
+
+	first := &model.EmailChange{UserID: "alice", Email: "a@example.com", TokenHash: "first"}
+	if err := repo.CreateEmailChange(ctx, first); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.CreateEmailChange(ctx, &model.EmailChange{UserID: "alice", Email: "b@example.com", TokenHash: "second"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// 사용자당 가장 최근 요청 하나만 유지됩니다.
+	if _, err := repo.FindEmailChangeByHash(ctx, "first"); !errors.Is(err, repository.ErrEmailChangeNotFound) {
+		t.Fatalf("err = %v, want ErrEmailChangeNotFound", err)
+	}
+	found, err := repo.FindEmailChangeByHash(ctx, "second")
+	if err != nil || found.Email != "b@example.com" {
+		t.Fatalf("change = %+v, err = %v", found, err)
+	}
+
+	if err := repo.DeleteEmailChanges(ctx, "alice"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.FindEmailChangeByHash(ctx, "second"); !errors.Is(err, repository.ErrEmailChangeNotFound) {
+		t.Fatalf("err = %v, want ErrEmailChangeNotFound", err)
+	}
+}
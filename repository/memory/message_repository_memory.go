@@ -0,0 +1,123 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/repository"
+)
+
+type MemoryMessageRepository struct {
+	Store *Store
+}
+
+func (r *MemoryMessageRepository) FindByID(ctx context.Context, id string) (*model.Message, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.Store.mu.RLock()
+	defer r.Store.mu.RUnlock()
+
+	message, ok := r.Store.messages[id]
+	if !ok {
+		return nil, repository.ErrMessageNotFound
+	}
+	message = r.Store.withSender(message)
+	return &message, nil
+}
+
+func (r *MemoryMessageRepository) FindByChatRoom(ctx context.Context, chatRoomID string, cursor string, limit int) ([]model.Message, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.Store.mu.RLock()
+	defer r.Store.mu.RUnlock()
+
+	var last *model.Message
+	if cursor != "" {
+		m, ok := r.Store.messages[cursor]
+		if !ok || m.ChatRoomID != chatRoomID {
+			return nil, repository.ErrMessageNotFound
+		}
+		last = &m
+	}
+
+	messages := make([]model.Message, 0)
+	for _, m := range r.Store.messages {
+		if m.ChatRoomID != chatRoomID {
+			continue
+		}
+		if last != nil && !before(m, *last) {
+			continue
+		}
+		messages = append(messages, m)
+	}
+	// createdAt 이 같은 메세지가 있을 수 있으므로 messageID 로 순서를 고정합니다.
+	sort.Slice(messages, func(i, j int) bool {
+		return before(messages[j], messages[i])
+	})
+	if limit >= 0 && len(messages) > limit {
+		messages = messages[:limit]
+	}
+	for i := range messages {
+		messages[i] = r.Store.withSender(messages[i])
+	}
+
+	return messages, nil
+}
+
+func (r *MemoryMessageRepository) Create(ctx context.Context, message *model.Message) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	if err := message.BeforeCreate(nil); err != nil {
+		return err
+	}
+	if _, ok := r.Store.messages[message.MessageID]; ok {
+		return repository.ErrDuplicate
+	}
+	now := time.Now()
+	if message.CreatedAt.IsZero() {
+		message.CreatedAt = now
+	}
+	message.UpdatedAt = now
+
+	// message.Translations 도 함께 저장됩니다.
+	translations := make([]model.MessageTranslation, len(message.Translations))
+	for i := range message.Translations {
+		t := &message.Translations[i]
+		t.MessageID = message.MessageID
+		if t.CreatedAt.IsZero() {
+			t.CreatedAt = now
+		}
+		translations[i] = *t
+	}
+	stored := *message
+	stored.Sender = model.User{}
+	stored.Translations = nil
+	r.Store.messages[message.MessageID] = stored
+	r.Store.translations[message.MessageID] = translations
+
+	if chatRoom, ok := r.Store.chatRooms[message.ChatRoomID]; ok {
+		chatRoom.LastMessage = message.Content
+		chatRoom.LastMessageAt = message.CreatedAt
+		r.Store.chatRooms[message.ChatRoomID] = chatRoom
+	}
+	return nil
+}
+
+// before는 (createdAt, messageID) 순서로 a 가 b 보다 앞서는지 반환합니다.
+func before(a, b model.Message) bool {
+	if a.CreatedAt.Equal(b.CreatedAt) {
+		return a.MessageID < b.MessageID
+	}
+	return a.CreatedAt.Before(b.CreatedAt)
+}
@@ -0,0 +1,193 @@
+// memory 패키지는 repository 인터페이스의 in-memory 구현을 제공합니다.
+//
+// 데이터베이스 없이 서버를 실행하거나 테스트할 때 사용하며, MariaDB 구현과 같은 의미를 따릅니다.
+//   - 레코드가 없으면 repository.ErrUserNotFound 등 repository 의 not found 오류를 반환합니다.
+//   - unique 제약을 어기면 repository.ErrEmailTaken(사용자 이메일), repository.ErrDuplicate(그 외)를 반환합니다.
+//   - MariaDB 구현이 Preload 하는 연관 데이터(Owner, Members.User, Sender 등)를 함께 채웁니다.
+//   - ctx 가 이미 취소되었으면 아무것도 읽거나 쓰지 않고 apperror.Canceled 오류를 반환합니다.
+//
+// 모든 저장소는 하나의 Store 를 공유하며, 반환하는 값은 복사본이므로 호출한 쪽에서 수정해도 저장된 값은 바뀌지 않습니다.
+package memory
+
+import (
+	"context"
+	"maps"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/B-Bridger/server/apperror"
+	"github.com/B-Bridger/server/model"
+)
+
+// pairKey는 두 개의 ID 로 이루어진 복합 키입니다. (chatRoomID + userID 등)
+type pairKey struct {
+	a, b string
+}
+
+// Store는 in-memory 저장소들이 공유하는 데이터입니다.
+// 여러 goroutine 에서 동시에 사용해도 안전합니다.
+type Store struct {
+	mu sync.RWMutex
+	// 트랜잭션(MemoryUnitOfWork.Do)을 한 번에 하나씩 실행하기 위한 lock
+	txMu sync.Mutex
+
+	users              map[string]model.User
+	chatRooms          map[string]model.ChatRoom
+	members            map[pairKey]model.ChatRoomMember
+	chatRoomCompanies  map[pairKey]model.ChatRoomCompany
+	messages           map[string]model.Message
+	translations       map[string][]model.MessageTranslation
+	refreshTokens      map[string]model.RefreshToken
+	revokedTokens      map[string]model.RevokedToken
+	companies          map[string]model.Company
+	glossaryTerms      map[string]model.GlossaryTerm
+	devices            map[string]model.Device
+	emailChanges       map[string]model.EmailChange
+	companyInvitations map[string]model.CompanyInvitation
+
+	// 트랜잭션 복사본이 만들어진 시점의 데이터, commit 할 때 트랜잭션이 변경한 레코드를 찾는 데 사용합니다.
+	base *Store
+}
+
+// NewStore는 비어있는 Store 를 생성합니다.
+func NewStore() *Store {
+	return &Store{
+		users:              make(map[string]model.User),
+		chatRooms:          make(map[string]model.ChatRoom),
+		members:            make(map[pairKey]model.ChatRoomMember),
+		chatRoomCompanies:  make(map[pairKey]model.ChatRoomCompany),
+		messages:           make(map[string]model.Message),
+		translations:       make(map[string][]model.MessageTranslation),
+		refreshTokens:      make(map[string]model.RefreshToken),
+		revokedTokens:      make(map[string]model.RevokedToken),
+		companies:          make(map[string]model.Company),
+		glossaryTerms:      make(map[string]model.GlossaryTerm),
+		devices:            make(map[string]model.Device),
+		emailChanges:       make(map[string]model.EmailChange),
+		companyInvitations: make(map[string]model.CompanyInvitation),
+	}
+}
+
+// clone은 트랜잭션에서 사용할 Store 의 복사본을 반환합니다.
+// 저장된 값의 slice 는 수정하지 않고 새로 할당하여 교체하므로 map 만 복사합니다.
+func (s *Store) clone() *Store {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	tx := s.snapshot()
+	tx.base = s.snapshot()
+	return tx
+}
+
+// snapshot은 s 의 map 들을 복사한 Store 를 반환합니다. s.mu 를 잡은 상태에서 호출해야 합니다.
+func (s *Store) snapshot() *Store {
+	return &Store{
+		users:              maps.Clone(s.users),
+		chatRooms:          maps.Clone(s.chatRooms),
+		members:            maps.Clone(s.members),
+		chatRoomCompanies:  maps.Clone(s.chatRoomCompanies),
+		messages:           maps.Clone(s.messages),
+		translations:       maps.Clone(s.translations),
+		refreshTokens:      maps.Clone(s.refreshTokens),
+		revokedTokens:      maps.Clone(s.revokedTokens),
+		companies:          maps.Clone(s.companies),
+		glossaryTerms:      maps.Clone(s.glossaryTerms),
+		devices:            maps.Clone(s.devices),
+		emailChanges:       maps.Clone(s.emailChanges),
+		companyInvitations: maps.Clone(s.companyInvitations),
+	}
+}
+
+// commit은 트랜잭션(tx)에서 추가, 수정, 삭제한 레코드만 s 에 반영합니다.
+// 트랜잭션 밖에서 그 사이 저장된 다른 레코드(로그인 중 발급된 refresh token 등)는 그대로 유지되며,
+// 같은 레코드를 양쪽에서 변경했다면 트랜잭션의 값이 남습니다.
+func (s *Store) commit(tx *Store) {
+	tx.mu.RLock()
+	defer tx.mu.RUnlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	base := tx.base
+	applyChanges(s.users, base.users, tx.users)
+	applyChanges(s.chatRooms, base.chatRooms, tx.chatRooms)
+	applyChanges(s.members, base.members, tx.members)
+	applyChanges(s.chatRoomCompanies, base.chatRoomCompanies, tx.chatRoomCompanies)
+	applyChanges(s.messages, base.messages, tx.messages)
+	applyChanges(s.translations, base.translations, tx.translations)
+	applyChanges(s.refreshTokens, base.refreshTokens, tx.refreshTokens)
+	applyChanges(s.revokedTokens, base.revokedTokens, tx.revokedTokens)
+	applyChanges(s.companies, base.companies, tx.companies)
+	applyChanges(s.glossaryTerms, base.glossaryTerms, tx.glossaryTerms)
+	applyChanges(s.devices, base.devices, tx.devices)
+	applyChanges(s.emailChanges, base.emailChanges, tx.emailChanges)
+	applyChanges(s.companyInvitations, base.companyInvitations, tx.companyInvitations)
+}
+
+// applyChanges는 base 와 비교하여 tx 에서 추가, 수정, 삭제된 키만 dst 에 반영합니다.
+func applyChanges[K comparable, V any](dst, base, tx map[K]V) {
+	for k, v := range tx {
+		if old, ok := base[k]; !ok || !reflect.DeepEqual(old, v) {
+			dst[k] = v
+		}
+	}
+	for k := range base {
+		if _, ok := tx[k]; !ok {
+			delete(dst, k)
+		}
+	}
+}
+
+// checkContext는 ctx 가 취소되었거나 deadline 이 지났으면 MariaDB 구현과 같은 오류를 반환합니다.
+func checkContext(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return apperror.Canceled(err)
+	}
+	return nil
+}
+
+// 아래 함수들은 s.mu 를 잡은 상태에서 호출해야 합니다.
+
+// chatRoomMembers는 채팅방 멤버를 사용자 정보와 함께 참여 순으로 반환합니다.
+func (s *Store) chatRoomMembers(chatRoomID string) []model.ChatRoomMember {
+	members := make([]model.ChatRoomMember, 0)
+	for key, m := range s.members {
+		if key.a != chatRoomID {
+			continue
+		}
+		m.User = s.users[m.UserID]
+		members = append(members, m)
+	}
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].JoinedAt.Equal(members[j].JoinedAt) {
+			return members[i].UserID < members[j].UserID
+		}
+		return members[i].JoinedAt.Before(members[j].JoinedAt)
+	})
+	return members
+}
+
+// withOwner는 채팅방에 Owner 를 채워 반환합니다.
+func (s *Store) withOwner(chatRoom model.ChatRoom) model.ChatRoom {
+	chatRoom.Owner = s.users[chatRoom.UserID]
+	chatRoom.Members = nil
+	chatRoom.Companies = nil
+	return chatRoom
+}
+
+// withSender는 메세지에 Sender, Translations 를 채워 반환합니다.
+func (s *Store) withSender(message model.Message) model.Message {
+	message.Sender = s.users[message.UserID]
+	translations := s.translations[message.MessageID]
+	message.Translations = append([]model.MessageTranslation(nil), translations...)
+	return message
+}
+
+// copyTerm은 번역어 목록까지 복사한 GlossaryTerm 을 반환합니다.
+func copyTerm(term model.GlossaryTerm) model.GlossaryTerm {
+	term.Targets = append([]model.GlossaryTarget(nil), term.Targets...)
+	sort.Slice(term.Targets, func(i, j int) bool {
+		return term.Targets[i].Language < term.Targets[j].Language
+	})
+	return term
+}
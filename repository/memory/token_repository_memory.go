@@ -0,0 +1,148 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/repository"
+)
+
+type MemoryTokenRepository struct {
+	Store *Store
+}
+
+func (r *MemoryTokenRepository) CreateRefreshToken(ctx context.Context, token *model.RefreshToken) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	if err := token.BeforeCreate(nil); err != nil {
+		return err
+	}
+	if _, ok := r.Store.refreshTokens[token.TokenID]; ok {
+		return repository.ErrDuplicate
+	}
+	for _, t := range r.Store.refreshTokens {
+		if t.TokenHash == token.TokenHash {
+			return repository.ErrDuplicate
+		}
+	}
+	if token.CreatedAt.IsZero() {
+		token.CreatedAt = time.Now()
+	}
+
+	r.Store.refreshTokens[token.TokenID] = *token
+	return nil
+}
+
+func (r *MemoryTokenRepository) FindRefreshTokenByHash(ctx context.Context, hash string) (*model.RefreshToken, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.Store.mu.RLock()
+	defer r.Store.mu.RUnlock()
+
+	for _, t := range r.Store.refreshTokens {
+		if t.TokenHash == hash {
+			return &t, nil
+		}
+	}
+	return nil, repository.ErrRefreshTokenNotFound
+}
+
+func (r *MemoryTokenRepository) RevokeRefreshToken(ctx context.Context, tokenID string) (bool, error) {
+	if err := checkContext(ctx); err != nil {
+		return false, err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	t, ok := r.Store.refreshTokens[tokenID]
+	if !ok || t.RevokedAt != nil {
+		return false, nil
+	}
+	now := time.Now()
+	t.RevokedAt = &now
+	r.Store.refreshTokens[tokenID] = t
+	return true, nil
+}
+
+func (r *MemoryTokenRepository) RevokeFamily(ctx context.Context, familyID string) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	now := time.Now()
+	for tokenID, t := range r.Store.refreshTokens {
+		if t.FamilyID == familyID && t.RevokedAt == nil {
+			revokedAt := now
+			t.RevokedAt = &revokedAt
+			r.Store.refreshTokens[tokenID] = t
+		}
+	}
+	return nil
+}
+
+func (r *MemoryTokenRepository) RevokeOtherFamilies(ctx context.Context, userID, keepFamilyID string) ([]string, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	now := time.Now()
+	revoked := make(map[string]struct{})
+	for tokenID, t := range r.Store.refreshTokens {
+		if t.UserID == userID && t.FamilyID != keepFamilyID && t.RevokedAt == nil {
+			revokedAt := now
+			t.RevokedAt = &revokedAt
+			r.Store.refreshTokens[tokenID] = t
+			revoked[t.FamilyID] = struct{}{}
+		}
+	}
+
+	familyIDs := make([]string, 0, len(revoked))
+	for familyID := range revoked {
+		familyIDs = append(familyIDs, familyID)
+	}
+	sort.Strings(familyIDs)
+	return familyIDs, nil
+}
+
+func (r *MemoryTokenRepository) RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	if _, ok := r.Store.revokedTokens[jti]; ok {
+		return nil
+	}
+	r.Store.revokedTokens[jti] = model.RevokedToken{JTI: jti, ExpiresAt: expiresAt, CreatedAt: time.Now()}
+	return nil
+}
+
+func (r *MemoryTokenRepository) IsAccessTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	if err := checkContext(ctx); err != nil {
+		return false, err
+	}
+
+	r.Store.mu.RLock()
+	defer r.Store.mu.RUnlock()
+
+	_, ok := r.Store.revokedTokens[jti]
+	return ok, nil
+}
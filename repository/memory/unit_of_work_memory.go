@@ -0,0 +1,59 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/B-Bridger/server/repository"
+)
+
+// context 에 진행 중인 트랜잭션의 Store 를 저장하는 키
+type txKey struct{}
+
+// MemoryUnitOfWork는 Store 의 복사본으로 repository.UnitOfWork 를 구현합니다.
+//
+// 트랜잭션은 Store 의 복사본에서 실행되어 commit 전까지 다른 요청에 보이지 않으며,
+// commit 하면 복사본에서 변경한 레코드만 Store 에 반영하고 rollback 하면 복사본을 버립니다.
+// 트랜잭션 밖의 쓰기는 txMu 를 기다리지 않으므로, 트랜잭션 중에 저장된 다른 레코드는 commit 후에도 유지됩니다.
+// 트랜잭션은 한 번에 하나씩 실행되므로, fn 안에서 트랜잭션 ctx 가 아닌 ctx 로 Do 를 호출하면 안 됩니다.
+type MemoryUnitOfWork struct {
+	Store *Store
+}
+
+// NewRepositories는 store 를 사용하는 저장소 묶음을 생성합니다.
+func NewRepositories(store *Store) *repository.Repositories {
+	return &repository.Repositories{
+		Users:           &MemoryUserRepository{Store: store},
+		ChatRooms:       &MemoryChatRoomRepository{Store: store},
+		ChatRoomMembers: &MemoryChatRoomMemberRepository{Store: store},
+		Messages:        &MemoryMessageRepository{Store: store},
+		Tokens:          &MemoryTokenRepository{Store: store},
+		Companies:       &MemoryCompanyRepository{Store: store},
+		Glossary:        &MemoryGlossaryRepository{Store: store},
+		Devices:         &MemoryDeviceRepository{Store: store},
+	}
+}
+
+func (u *MemoryUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context, repos *repository.Repositories) error) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	// 이미 트랜잭션 안이면 그 복사본을 다시 복사하여 savepoint 처럼 동작합니다.
+	parent, nested := ctx.Value(txKey{}).(*Store)
+	if !nested {
+		parent = u.Store
+		parent.txMu.Lock()
+		defer parent.txMu.Unlock()
+	}
+
+	tx := parent.clone()
+	if err := fn(context.WithValue(ctx, txKey{}, tx), NewRepositories(tx)); err != nil {
+		return err
+	}
+	// MariaDB 와 같이 commit 전에 요청이 취소되면 rollback 합니다.
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+	parent.commit(tx)
+	return nil
+}
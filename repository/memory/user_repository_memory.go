@@ -0,0 +1,236 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/repository"
+)
+
+type MemoryUserRepository struct {
+	Store *Store
+}
+
+func (r *MemoryUserRepository) FindByID(ctx context.Context, id string) (*model.User, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.Store.mu.RLock()
+	defer r.Store.mu.RUnlock()
+
+	user, ok := r.Store.users[id]
+	if !ok {
+		return nil, repository.ErrUserNotFound
+	}
+	return &user, nil
+}
+
+func (r *MemoryUserRepository) FindByIDs(ctx context.Context, ids []string) ([]model.User, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.Store.mu.RLock()
+	defer r.Store.mu.RUnlock()
+
+	users := make([]model.User, 0, len(ids))
+	seen := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		if user, ok := r.Store.users[id]; ok {
+			users = append(users, user)
+		}
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].UserID < users[j].UserID
+	})
+	return users, nil
+}
+
+func (r *MemoryUserRepository) FindByEmail(ctx context.Context, email string) (*model.User, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.Store.mu.RLock()
+	defer r.Store.mu.RUnlock()
+
+	for _, user := range r.Store.users {
+		if user.Email == email {
+			return &user, nil
+		}
+	}
+	return nil, repository.ErrUserNotFound
+}
+
+func (r *MemoryUserRepository) Create(ctx context.Context, user *model.User) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	if err := user.BeforeCreate(nil); err != nil {
+		return err
+	}
+	if _, ok := r.Store.users[user.UserID]; ok {
+		return repository.ErrEmailTaken
+	}
+	if r.emailTaken(user.Email, user.UserID) {
+		return repository.ErrEmailTaken
+	}
+	if user.CreatedAt.IsZero() {
+		user.CreatedAt = time.Now()
+	}
+
+	r.Store.users[user.UserID] = *user
+	return nil
+}
+
+func (r *MemoryUserRepository) UpdateProfile(ctx context.Context, user *model.User) error {
+	return r.update(ctx, user.UserID, func(stored *model.User) error {
+		stored.Name = user.Name
+		stored.Language = user.Language
+		stored.Profile = user.Profile
+		return nil
+	})
+}
+
+func (r *MemoryUserRepository) UpdateEmail(ctx context.Context, userID, email string) error {
+	return r.update(ctx, userID, func(stored *model.User) error {
+		if r.emailTaken(email, userID) {
+			return repository.ErrEmailTaken
+		}
+		stored.Email = email
+		return nil
+	})
+}
+
+func (r *MemoryUserRepository) UpdatePassword(ctx context.Context, userID, passwordHash string) error {
+	return r.update(ctx, userID, func(stored *model.User) error {
+		stored.Password = passwordHash
+		return nil
+	})
+}
+
+// update는 저장된 사용자를 fn 으로 수정합니다. fn 은 Store.mu 를 잡은 상태에서 호출됩니다.
+func (r *MemoryUserRepository) update(ctx context.Context, userID string, fn func(stored *model.User) error) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	stored, ok := r.Store.users[userID]
+	if !ok {
+		return repository.ErrUserNotFound
+	}
+	if err := fn(&stored); err != nil {
+		return err
+	}
+	r.Store.users[userID] = stored
+	return nil
+}
+
+func (r *MemoryUserRepository) Delete(ctx context.Context, id string) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	delete(r.Store.users, id)
+	return nil
+}
+
+func (r *MemoryUserRepository) UpdateProfileImage(ctx context.Context, userID string, imageURL string) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	if user, ok := r.Store.users[userID]; ok {
+		user.Profile = imageURL
+		r.Store.users[userID] = user
+	}
+	return nil
+}
+
+func (r *MemoryUserRepository) CreateEmailChange(ctx context.Context, change *model.EmailChange) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	if err := change.BeforeCreate(nil); err != nil {
+		return err
+	}
+	for changeID, c := range r.Store.emailChanges {
+		if c.UserID == change.UserID {
+			delete(r.Store.emailChanges, changeID)
+		} else if c.TokenHash == change.TokenHash {
+			return repository.ErrDuplicate
+		}
+	}
+	if change.CreatedAt.IsZero() {
+		change.CreatedAt = time.Now()
+	}
+
+	r.Store.emailChanges[change.ChangeID] = *change
+	return nil
+}
+
+func (r *MemoryUserRepository) FindEmailChangeByHash(ctx context.Context, hash string) (*model.EmailChange, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+
+	r.Store.mu.RLock()
+	defer r.Store.mu.RUnlock()
+
+	for _, c := range r.Store.emailChanges {
+		if c.TokenHash == hash {
+			return &c, nil
+		}
+	}
+	return nil, repository.ErrEmailChangeNotFound
+}
+
+func (r *MemoryUserRepository) DeleteEmailChanges(ctx context.Context, userID string) error {
+	if err := checkContext(ctx); err != nil {
+		return err
+	}
+
+	r.Store.mu.Lock()
+	defer r.Store.mu.Unlock()
+
+	for changeID, c := range r.Store.emailChanges {
+		if c.UserID == userID {
+			delete(r.Store.emailChanges, changeID)
+		}
+	}
+	return nil
+}
+
+// emailTaken은 다른 사용자가 같은 이메일을 사용 중인지 반환합니다.
+func (r *MemoryUserRepository) emailTaken(email, userID string) bool {
+	for _, u := range r.Store.users {
+		if u.Email == email && u.UserID != userID {
+			return true
+		}
+	}
+	return false
+}
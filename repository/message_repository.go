@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/B-Bridger/server/model"
+)
+
+// Message 관련 데이터 엑세스를 추상화한 인터페이스입니다.
+type MessageRepository interface {
+	// MessageID를 통해 Message 객체를 번역본과 함께 반환합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - id: 메세지의 고유 ID
+	//
+	// 반환 값
+	//   - *Message: 불러온 Message 객체
+	//   - error: 실패 시 error 메세지
+	FindByID(ctx context.Context, id string) (*model.Message, error)
+
+	// 채팅방의 메세지를 번역본과 함께 최신순으로 반환합니다.
+	// cursor가 주어지면 해당 메세지보다 이전에 작성된 메세지만 반환합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - chatRoomID: 채팅방의 고유 ID
+	//   - cursor: 마지막으로 조회한 메세지의 고유 ID (첫 페이지는 빈 문자열)
+	//   - limit: 최대 조회 개수
+	//
+	// 반환 값
+	//   - []Message: 불러온 Message 목록
+	//   - error: 실패 시 error 메세지
+	FindByChatRoom(ctx context.Context, chatRoomID string, cursor string, limit int) ([]model.Message, error)
+
+	// 메세지 레코드와 번역본(message.Translations)을 생성하고, 같은 트랜잭션 안에서
+	// 채팅방의 LastMessage, LastMessageAt 을 갱신합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - message: Message 객체 포인터
+	//
+	// 반환 값
+	//   - error: 실패 시 error 메세지
+	Create(ctx context.Context, message *model.Message) error
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/B-Bridger/server/model"
+)
+
+// 인증 토큰(refresh token, access token denylist) 관련 데이터 엑세스를 추상화한 인터페이스입니다.
+type TokenRepository interface {
+	// refresh token 레코드를 생성합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - token: RefreshToken 객체 포인터 (TokenHash 에는 해시값이 들어있어야 합니다)
+	//
+	// 반환 값
+	//   - error: 실패 시 error 메세지
+	CreateRefreshToken(ctx context.Context, token *model.RefreshToken) error
+
+	// 해시값을 통해 refresh token 을 반환합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - hash: refresh token 원문의 SHA-256 해시
+	//
+	// 반환 값
+	//   - *RefreshToken: 불러온 RefreshToken 객체
+	//   - error: 실패 시 error 메세지
+	FindRefreshTokenByHash(ctx context.Context, hash string) (*model.RefreshToken, error)
+
+	// 아직 폐기되지 않은 refresh token 을 폐기합니다.
+	// 동시에 같은 토큰으로 재발급을 요청한 경우 하나의 요청만 성공합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - tokenID: refresh token 의 고유 ID
+	//
+	// 반환 값
+	//   - bool: 이번 호출로 폐기되었으면 true, 이미 폐기된 토큰이면 false
+	//   - error: 실패 시 error 메세지
+	RevokeRefreshToken(ctx context.Context, tokenID string) (bool, error)
+
+	// 같은 family 의 refresh token 을 모두 폐기합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - familyID: refresh token family 의 고유 ID
+	//
+	// 반환 값
+	//   - error: 실패 시 error 메세지
+	RevokeFamily(ctx context.Context, familyID string) error
+
+	// 사용자의 refresh token 중 keepFamilyID 가 아닌 family 의 토큰을 모두 폐기합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - userID: 사용자의 고유 ID
+	//   - keepFamilyID: 유지할 refresh token family 의 고유 ID (현재 로그인)
+	//
+	// 반환 값
+	//   - []string: 폐기한 토큰이 있는 family ID 목록
+	//   - error: 실패 시 error 메세지
+	RevokeOtherFamilies(ctx context.Context, userID, keepFamilyID string) ([]string, error)
+
+	// access token 의 jti 를 denylist 에 추가합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - jti: access token 의 고유 ID
+	//   - expiresAt: access token 의 만료 시각 (이후에는 denylist 에서 제거해도 됩니다)
+	//
+	// 반환 값
+	//   - error: 실패 시 error 메세지
+	RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error
+
+	// access token 이 denylist 에 있는지 확인합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - jti: access token 의 고유 ID
+	//
+	// 반환 값
+	//   - bool: 폐기된 토큰이면 true
+	//   - error: 실패 시 error 메세지
+	IsAccessTokenRevoked(ctx context.Context, jti string) (bool, error)
+}
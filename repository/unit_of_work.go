@@ -0,0 +1,39 @@
+package repository
+
+import "context"
+
+// Repositories는 하나의 트랜잭션에 묶인 저장소 묶음입니다.
+// UnitOfWork.Do 의 fn 안에서만 사용해야 합니다.
+type Repositories struct {
+	Users           UserRepository
+	ChatRooms       ChatRoomRepository
+	ChatRoomMembers ChatRoomMemberRepository
+	Messages        MessageRepository
+	Tokens          TokenRepository
+	Companies       CompanyRepository
+	Glossary        GlossaryRepository
+	Devices         DeviceRepository
+}
+
+// 여러 저장소에 걸친 변경을 하나의 트랜잭션으로 묶는 unit of work 를 추상화한 인터페이스입니다.
+//
+//	err := s.UnitOfWork.Do(ctx, func(ctx context.Context, repos *repository.Repositories) error {
+//		if err := repos.ChatRoomMembers.AddMembers(ctx, members); err != nil {
+//			return err
+//		}
+//		return repos.ChatRooms.AddCompanies(ctx, chatRoomID, companyIDs)
+//	})
+type UnitOfWork interface {
+	// fn 을 트랜잭션 안에서 실행합니다.
+	// fn 이 nil 을 반환하면 commit 하고, error 를 반환하거나 panic 이 발생하면 rollback 합니다.
+	// fn 이 받은 ctx 로 Do 를 다시 호출하면 savepoint 로 중첩되어, 안쪽 fn 의 실패는 안쪽 변경만 되돌립니다.
+	// fn 안에서는 트랜잭션에 묶이지 않은 service 의 저장소 대신 repos 를 사용해야 합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - fn: 트랜잭션 안에서 실행할 함수
+	//
+	// 반환 값
+	//   - error: fn 이 반환한 error 또는 commit 실패 시 error 메세지
+	Do(ctx context.Context, fn func(ctx context.Context, repos *Repositories) error) error
+}
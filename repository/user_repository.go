@@ -1,6 +1,10 @@
 package repository
 
-import "github.com/B-Bridger/server/model"
+import (
+	"context"
+
+	"github.com/B-Bridger/server/model"
+)
 
 // User 관련 데이터 엑세스를 추상화한 인터페이스입니다.
 // SOLID 원칙에 따라, Interface 구현은 mariaDB에서 진행합니다.
@@ -8,58 +12,129 @@ type UserRepository interface {
 	// UserID를 통해 user 객체를 반환합니다.
 	//
 	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
 	//   - id: 사용자의 고유 ID
 	//
 	// 반환 값
 	//   - *User: 불러온 user 객체
 	//   - error: 실패 시 error 메세지
-	FindByID(id string) (*model.User, error)
+	FindByID(ctx context.Context, id string) (*model.User, error)
+
+	// 여러 UserID에 해당하는 user 목록을 반환합니다.
+	// 존재하지 않는 ID는 결과에서 제외됩니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - ids: 사용자의 고유 ID 목록
+	//
+	// 반환 값
+	//   - []User: 불러온 user 목록
+	//   - error: 실패 시 error 메세지
+	FindByIDs(ctx context.Context, ids []string) ([]model.User, error)
 
 	// email을 통해 user 객체를 반환합니다.
 	//
 	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
 	//   - id: 사용자의 고유 ID
 	//
 	// 반환 값
 	//   - *User: 불러온 user 객체
 	//   - error: 실패 시 error 메세지
-	FindByEmail(email string) (*model.User, error)
+	FindByEmail(ctx context.Context, email string) (*model.User, error)
 
 	// 사용자 레코드를 생성합니다.
 	//
 	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
 	//   - user: user 객체 포인터
 	//
 	// 반환 값
 	//   - error: 실패 시 error 메세지
-	Create(user *model.User) error
+	Create(ctx context.Context, user *model.User) error
 
-	// 기존에 존재하는 사용자 정보를 수정합니다.
+	// 사용자가 직접 수정할 수 있는 필드(Name, Language, Profile)만 주어진 값으로 저장합니다.
+	// 이메일, 비밀번호, 회사 소속 등 나머지 열은 변경하지 않습니다.
 	//
 	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
 	//   - user: user 객체 포인터
 	//
 	// 반환 값
-	//   - *User: 수정된 user 객체
-	//   - error: 실패 시 error 메세지
-	Update(user *model.User) (*model.User, error)
+	//   - error: 사용자가 없으면 ErrUserNotFound, 실패 시 error 메세지
+	UpdateProfile(ctx context.Context, user *model.User) error
+
+	// 사용자의 이메일만 변경합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - userID: 사용자의 고유 ID
+	//   - email: 새 이메일
+	//
+	// 반환 값
+	//   - error: 사용자가 없으면 ErrUserNotFound, 이미 사용 중인 이메일이면 ErrEmailTaken
+	UpdateEmail(ctx context.Context, userID, email string) error
+
+	// 사용자의 비밀번호 해시만 변경합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - userID: 사용자의 고유 ID
+	//   - passwordHash: bcrypt 로 해시한 새 비밀번호
+	//
+	// 반환 값
+	//   - error: 사용자가 없으면 ErrUserNotFound, 실패 시 error 메세지
+	UpdatePassword(ctx context.Context, userID, passwordHash string) error
 
 	// 사용자 레코드를 삭제합니다.
 	//
 	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
 	//   - id: 사용자의 고유 ID
 	//
 	// 반환 값
 	//   - error: 실패 시 error 메세지
-	Delete(id string) error
+	Delete(ctx context.Context, id string) error
 
 	// 사용자의 프로필 이미지 경로를 저장합니다.
 	//
 	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
 	// 	 - id: 사용자의 고유 ID
 	// 	 - imageUrl: 이미지 경로
 	//
 	// 반환 값
 	//   - error: 실패 시 error 메세지
-	UpdateProfileImage(id string, imageURL string) error
+	UpdateProfileImage(ctx context.Context, id string, imageURL string) error
+
+	// 이메일 변경 요청을 저장합니다. 같은 사용자의 이전 요청은 삭제됩니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - change: EmailChange 객체 포인터
+	//
+	// 반환 값
+	//   - error: 실패 시 error 메세지
+	CreateEmailChange(ctx context.Context, change *model.EmailChange) error
+
+	// 확인 토큰의 해시로 이메일 변경 요청을 반환합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - hash: 확인 토큰 원문의 SHA-256 해시
+	//
+	// 반환 값
+	//   - *EmailChange: 불러온 EmailChange 객체
+	//   - error: 없으면 ErrEmailChangeNotFound, 실패 시 error 메세지
+	FindEmailChangeByHash(ctx context.Context, hash string) (*model.EmailChange, error)
+
+	// 사용자의 이메일 변경 요청을 모두 삭제합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - userID: 사용자의 고유 ID
+	//
+	// 반환 값
+	//   - error: 실패 시 error 메세지
+	DeleteEmailChanges(ctx context.Context, userID string) error
 }
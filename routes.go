@@ -1,48 +1,106 @@
 package main
 
 import (
+	"github.com/B-Bridger/server/config"
 	"github.com/B-Bridger/server/handler"
+	"github.com/B-Bridger/server/metrics"
 	"github.com/B-Bridger/server/middleware"
+	"github.com/B-Bridger/server/model"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 )
 
-func SetupRouter(userHandler *handler.UserHandler, chatRoomHandler *handler.ChatRoomHandler) *gin.Engine {
-	r := gin.Default()
+func SetupRouter(cfg *config.Config, m *metrics.Metrics, userHandler *handler.UserHandler, chatRoomHandler *handler.ChatRoomHandler, messageHandler *handler.MessageHandler, webSocketHandler *handler.WebSocketHandler, companyHandler *handler.CompanyHandler, glossaryHandler *handler.GlossaryHandler, healthHandler *handler.HealthHandler, tokenDenylist middleware.TokenDenylist) *gin.Engine {
+	r := gin.New()
+	r.Use(middleware.Metrics(m))
+	r.Use(middleware.RequestID())
+	r.Use(middleware.Tracing())
+	r.Use(middleware.Logger())
+	r.Use(middleware.Recovery())
 	r.Use(cors.Default())
+	r.Use(middleware.ErrorHandler())
+	r.Use(middleware.Timeout(cfg.Server.RequestTimeout))
 
 	// 사용자 관련 라우팅 설정
-	authRequiredUser := r.Group("/users", middleware.AuthMiddleware())
+	authRequiredUser := r.Group("/users", middleware.AuthMiddleware(cfg.Auth.Secret, tokenDenylist))
 	{
 		authRequiredUser.GET("/", userHandler.GetUser)
-		authRequiredUser.PUT("/", userHandler.UpdateUser)
+		authRequiredUser.PATCH("/", userHandler.UpdateUser)
+		authRequiredUser.PUT("/", userHandler.ReplaceUser)
+		authRequiredUser.POST("/email", userHandler.RequestEmailChange)
+		authRequiredUser.PUT("/password", userHandler.ChangePassword)
 		authRequiredUser.DELETE("/", userHandler.DeleteUser)
 		authRequiredUser.POST("/profile-image", userHandler.UploadProfileImage)
+		authRequiredUser.GET("/devices", userHandler.GetDevices)
+		authRequiredUser.POST("/devices", userHandler.RegisterDevice)
+		authRequiredUser.DELETE("/devices", userHandler.RemoveSessionDevices)
+		authRequiredUser.DELETE("/devices/:deviceID", userHandler.RemoveDevice)
 	}
 	user := r.Group("/users")
 	{
 		user.POST("/", userHandler.CreateUser)
+		user.POST("/email/verify", userHandler.VerifyEmail)
 	}
 	r.POST("/login", userHandler.Login)
+	r.POST("/token/refresh", userHandler.RefreshToken)
+	r.POST("/logout", middleware.AuthMiddleware(cfg.Auth.Secret, tokenDenylist), userHandler.Logout)
 
 	// 채팅방 관련 라우팅 설정
-	authRequiredChatRoom := r.Group("/chat-room", middleware.AuthMiddleware())
+	authRequiredChatRoom := r.Group("/chat-room", middleware.AuthMiddleware(cfg.Auth.Secret, tokenDenylist))
 	{
 		authRequiredChatRoom.POST("/", chatRoomHandler.CreateChatRoom)
+		authRequiredChatRoom.GET("/:id", chatRoomHandler.GetChatRoom)
+		authRequiredChatRoom.PATCH("/:id", chatRoomHandler.UpdateChatRoom)
 		authRequiredChatRoom.PUT("/:id", chatRoomHandler.UpdateChatRoom)
 		authRequiredChatRoom.DELETE("/:id", chatRoomHandler.DeleteChatRoom)
+		authRequiredChatRoom.POST("/:id/members", chatRoomHandler.AddMembers)
+		authRequiredChatRoom.DELETE("/:id/members", chatRoomHandler.RemoveMembers)
+		authRequiredChatRoom.PUT("/:id/mute", chatRoomHandler.MuteChatRoom)
+		authRequiredChatRoom.GET("/:id/messages", messageHandler.GetMessages)
+		authRequiredChatRoom.POST("/:id/messages", messageHandler.PostMessage)
+		authRequiredChatRoom.GET("/:id/messages/:messageID", messageHandler.GetMessage)
+		authRequiredChatRoom.GET("/:id/glossary", glossaryHandler.GetTerms(model.GlossaryScopeChatRoom))
+		authRequiredChatRoom.POST("/:id/glossary", glossaryHandler.CreateTerm(model.GlossaryScopeChatRoom))
+		authRequiredChatRoom.GET("/:id/glossary/export", glossaryHandler.ExportTerms(model.GlossaryScopeChatRoom))
+		authRequiredChatRoom.POST("/:id/glossary/import", glossaryHandler.ImportTerms(model.GlossaryScopeChatRoom))
+		authRequiredChatRoom.PUT("/:id/glossary/:termID", glossaryHandler.UpdateTerm(model.GlossaryScopeChatRoom))
+		authRequiredChatRoom.DELETE("/:id/glossary/:termID", glossaryHandler.DeleteTerm(model.GlossaryScopeChatRoom))
 	}
-	chatRoom := r.Group("/chat-room")
+	r.GET("/chat-room/:id/ws", middleware.WebSocketAuthMiddleware(cfg.Auth.Secret, tokenDenylist), webSocketHandler.Connect)
+	authRequiredChatRooms := r.Group("/chat-rooms", middleware.AuthMiddleware(cfg.Auth.Secret, tokenDenylist))
 	{
-		chatRoom.GET("/:id", chatRoomHandler.GetChatRoom)
+		authRequiredChatRooms.GET("/", chatRoomHandler.GetChatRooms)
 	}
-	authRequiredChatRooms := r.Group("/chat-rooms", middleware.AuthMiddleware())
+
+	// 회사 관련 라우팅 설정
+	authRequiredCompany := r.Group("/companies", middleware.AuthMiddleware(cfg.Auth.Secret, tokenDenylist))
 	{
-		authRequiredChatRooms.GET("/", chatRoomHandler.GetChatRoomByOwner)
+		authRequiredCompany.POST("/", companyHandler.CreateCompany)
+		authRequiredCompany.GET("/:id", companyHandler.GetCompany)
+		authRequiredCompany.PUT("/:id", companyHandler.UpdateCompany)
+		authRequiredCompany.GET("/:id/users", companyHandler.GetCompanyUsers)
+		authRequiredCompany.GET("/invitations", companyHandler.GetCompanyInvitations)
+		authRequiredCompany.POST("/invitations/:invitationID/accept", companyHandler.AcceptCompanyInvitation)
+		authRequiredCompany.DELETE("/invitations/:invitationID", companyHandler.DeleteCompanyInvitation)
+		authRequiredCompany.POST("/:id/invitations", companyHandler.InviteCompanyMember)
+		authRequiredCompany.PUT("/:id/members/:userID", companyHandler.UpdateCompanyMemberRole)
+		authRequiredCompany.DELETE("/:id/members/:userID", companyHandler.RemoveCompanyMember)
+		authRequiredCompany.GET("/:id/glossary", glossaryHandler.GetTerms(model.GlossaryScopeCompany))
+		authRequiredCompany.POST("/:id/glossary", glossaryHandler.CreateTerm(model.GlossaryScopeCompany))
+		authRequiredCompany.GET("/:id/glossary/export", glossaryHandler.ExportTerms(model.GlossaryScopeCompany))
+		authRequiredCompany.POST("/:id/glossary/import", glossaryHandler.ImportTerms(model.GlossaryScopeCompany))
+		authRequiredCompany.PUT("/:id/glossary/:termID", glossaryHandler.UpdateTerm(model.GlossaryScopeCompany))
+		authRequiredCompany.DELETE("/:id/glossary/:termID", glossaryHandler.DeleteTerm(model.GlossaryScopeCompany))
 	}
 
+	// 상태 확인
+	r.GET("/healthz", healthHandler.Healthz)
+	r.GET("/readyz", healthHandler.Readyz)
+	r.GET("/version", healthHandler.Version)
+	r.GET("/metrics", gin.WrapH(m.Handler()))
+
 	// Swagger & 정적 파일
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 	r.Static("/static", "./static")
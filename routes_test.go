@@ -0,0 +1,1151 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/B-Bridger/server/config"
+	"github.com/B-Bridger/server/handler"
+	"github.com/B-Bridger/server/hub"
+	"github.com/B-Bridger/server/metrics"
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/repository"
+	"github.com/B-Bridger/server/repository/memory"
+	"github.com/B-Bridger/server/service"
+	"github.com/B-Bridger/server/translation"
+	"github.com/B-Bridger/server/translation/local"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+// testServer는 in-memory 저장소로 구성한 라우터입니다.
+type testServer struct {
+	t      *testing.T
+	router *gin.Engine
+	hub    *hub.Hub
+	health *service.HealthService
+	emails *testEmailSender
+	store  *memory.Store
+}
+
+// testEmailSender는 발송한 이메일 확인 토큰을 이메일별로 기록합니다.
+type testEmailSender struct {
+	mu     sync.Mutex
+	tokens map[string]string
+}
+
+func (s *testEmailSender) SendEmailVerification(_ context.Context, email, token string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens[email] = token
+	return nil
+}
+
+// token은 email 로 마지막에 발송한 확인 토큰을 반환합니다.
+func (s *testEmailSender) token(email string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tokens[email]
+}
+
+// testUser는 가입 후 로그인한 사용자입니다.
+type testUser struct {
+	model.User
+	Token        string
+	RefreshToken string
+}
+
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	cfg := config.Default()
+	cfg.Auth.Secret = "test-secret-test-secret-test-secret"
+
+	m := metrics.New()
+	store := memory.NewStore()
+	unitOfWork := &memory.MemoryUnitOfWork{Store: store}
+	userRepo := &memory.MemoryUserRepository{Store: store}
+	tokenRepo := &memory.MemoryTokenRepository{Store: store}
+	deviceRepo := &memory.MemoryDeviceRepository{Store: store}
+	emails := &testEmailSender{tokens: map[string]string{}}
+	userService := &service.UserService{Repo: userRepo, TokenRepo: tokenRepo, DeviceRepo: deviceRepo, UnitOfWork: unitOfWork, EmailSender: emails, JWTSecret: cfg.Auth.Secret}
+	userHandler := &handler.UserHandler{Service: userService}
+	chatRoomRepo := &memory.MemoryChatRoomRepository{Store: store}
+	chatRoomMemberRepo := &memory.MemoryChatRoomMemberRepository{Store: store}
+	chatHub := hub.New()
+	m.RegisterWebSocketConnections(chatHub.Connections)
+	chatRoomService := &service.ChatRoomService{Repo: chatRoomRepo, MemberRepo: chatRoomMemberRepo, UserRepo: userRepo, UnitOfWork: unitOfWork, Connections: chatHub}
+	chatRoomHandler := &handler.ChatRoomHandler{Service: chatRoomService}
+	notificationService := &service.NotificationService{MemberRepo: chatRoomMemberRepo, DeviceRepo: deviceRepo, Presence: chatHub}
+	glossaryRepo := &memory.MemoryGlossaryRepository{Store: store}
+	glossaryService := &service.GlossaryService{Repo: glossaryRepo, UserRepo: userRepo, MemberRepo: chatRoomMemberRepo}
+	glossaryHandler := &handler.GlossaryHandler{Service: glossaryService}
+	messageRepo := &memory.MemoryMessageRepository{Store: store}
+	messageService := &service.MessageService{Repo: messageRepo, UserRepo: userRepo, ChatRoomRepo: chatRoomRepo, MemberRepo: chatRoomMemberRepo, GlossaryRepo: glossaryRepo, Translator: translation.EnforceGlossary(translation.Instrument(local.New(), m)), Broadcaster: chatHub, Notifier: notificationService}
+	messageHandler := &handler.MessageHandler{Service: messageService}
+	webSocketHandler := &handler.WebSocketHandler{Hub: chatHub, UserService: userService, ChatRoomService: chatRoomService, MessageService: messageService, AllowedOrigins: cfg.Server.AllowedOrigins}
+	companyRepo := &memory.MemoryCompanyRepository{Store: store}
+	companyService := &service.CompanyService{Repo: companyRepo, UserRepo: userRepo, UnitOfWork: unitOfWork}
+	companyHandler := &handler.CompanyHandler{Service: companyService}
+
+	healthService := &service.HealthService{}
+	healthHandler := &handler.HealthHandler{Service: healthService}
+
+	router := SetupRouter(cfg, m, userHandler, chatRoomHandler, messageHandler, webSocketHandler, companyHandler, glossaryHandler, healthHandler, tokenRepo)
+	return &testServer{t: t, router: router, hub: chatHub, health: healthService, emails: emails, store: store}
+}
+
+// do는 요청을 보내고 응답을 반환합니다. body 가 []byte 가 아니면 JSON 으로 인코딩합니다.
+func (s *testServer) do(method, path, token string, body interface{}) *httptest.ResponseRecorder {
+	s.t.Helper()
+
+	var reader io.Reader
+	contentType := ""
+	switch b := body.(type) {
+	case nil:
+	case []byte:
+		reader = bytes.NewReader(b)
+		contentType = "text/csv"
+	default:
+		data, err := json.Marshal(b)
+		if err != nil {
+			s.t.Fatalf("요청 body 인코딩 실패: %v", err)
+		}
+		reader = bytes.NewReader(data)
+		contentType = "application/json"
+		if method == http.MethodPatch {
+			contentType = "application/merge-patch+json"
+		}
+	}
+
+	req := httptest.NewRequest(method, path, reader)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, req)
+	return w
+}
+
+// expect는 요청을 보내고 상태 코드를 확인한 뒤 응답을 out 으로 디코딩합니다.
+func (s *testServer) expect(status int, method, path, token string, body interface{}, out interface{}) {
+	s.t.Helper()
+
+	w := s.do(method, path, token, body)
+	if w.Code != status {
+		s.t.Fatalf("%s %s: status = %d, want %d (body: %s)", method, path, w.Code, status, w.Body.String())
+	}
+	if out != nil {
+		if err := json.Unmarshal(w.Body.Bytes(), out); err != nil {
+			s.t.Fatalf("%s %s: 응답 디코딩 실패: %v", method, path, err)
+		}
+	}
+}
+
+// signUp은 사용자를 생성하고 로그인합니다.
+func (s *testServer) signUp(name, email, language string) *testUser {
+	s.t.Helper()
+
+	s.expect(http.StatusCreated, http.MethodPost, "/users/", "", model.CreateUserModel{Name: name, Email: email, Password: "password", Language: language}, nil)
+
+	var resp model.TokenResponse
+	s.expect(http.StatusOK, http.MethodPost, "/login", "", handler.LoginRequest{Email: email, Password: "password"}, &resp)
+	return &testUser{User: resp.User, Token: resp.Token, RefreshToken: resp.RefreshToken}
+}
+
+// createChatRoom은 owner 가 invitees 를 초대한 채팅방을 생성합니다.
+func (s *testServer) createChatRoom(owner *testUser, invitees ...*testUser) model.ChatRoom {
+	s.t.Helper()
+
+	var ids []string
+	for _, u := range invitees {
+		ids = append(ids, u.UserID)
+	}
+	var resp model.ChatRoomResponse
+	s.expect(http.StatusCreated, http.MethodPost, "/chat-room/", owner.Token, model.CreateChatRoomModel{InviteUserIDS: ids}, &resp)
+	return resp.ChatRoom
+}
+
+func TestUserRoutes(t *testing.T) {
+	s := newTestServer(t)
+	alice := s.signUp("Alice", "alice@example.com", "ko")
+
+	t.Run("duplicate email", func(t *testing.T) {
+		var resp model.ErrorResponse
+		s.expect(http.StatusConflict, http.MethodPost, "/users/", "", model.CreateUserModel{Name: "Alice", Email: "alice@example.com", Password: "password"}, &resp)
+		if resp.Code != "email_taken" {
+			t.Fatalf("code = %q, want %q", resp.Code, "email_taken")
+		}
+	})
+
+	t.Run("login failure does not reveal account", func(t *testing.T) {
+		var wrongPassword, unknownEmail model.ErrorResponse
+		s.expect(http.StatusUnauthorized, http.MethodPost, "/login", "", handler.LoginRequest{Email: "alice@example.com", Password: "wrong"}, &wrongPassword)
+		s.expect(http.StatusUnauthorized, http.MethodPost, "/login", "", handler.LoginRequest{Email: "nobody@example.com", Password: "wrong"}, &unknownEmail)
+		if wrongPassword != unknownEmail || wrongPassword.Code != "invalid_credentials" {
+			t.Fatalf("wrong password = %+v, unknown email = %+v", wrongPassword, unknownEmail)
+		}
+	})
+
+	t.Run("requires token", func(t *testing.T) {
+		var resp model.ErrorResponse
+		s.expect(http.StatusUnauthorized, http.MethodGet, "/users/", "", nil, &resp)
+		if resp.Code != "token_missing" || resp.Status != http.StatusUnauthorized {
+			t.Fatalf("resp = %+v", resp)
+		}
+		s.expect(http.StatusForbidden, http.MethodGet, "/users/", "invalid", nil, nil)
+	})
+
+	t.Run("get user", func(t *testing.T) {
+		var resp model.UserResponse
+		s.expect(http.StatusOK, http.MethodGet, "/users/", alice.Token, nil, &resp)
+		if resp.User.UserID != alice.UserID || resp.User.Email != "alice@example.com" {
+			t.Fatalf("user = %+v", resp.User)
+		}
+	})
+
+	t.Run("update user", func(t *testing.T) {
+		var resp model.UserResponse
+		s.expect(http.StatusOK, http.MethodPatch, "/users/", alice.Token, map[string]any{"name": "Alice Kim", "language": "en"}, &resp)
+		if resp.User.Name != "Alice Kim" || resp.User.Language != "en" || resp.User.Email != "alice@example.com" {
+			t.Fatalf("user = %+v", resp.User)
+		}
+		// 변경된 언어는 이번 응답부터 적용됩니다.
+		if resp.Message != "User updated successfully" {
+			t.Fatalf("message = %q", resp.Message)
+		}
+
+		// 요청에 없는 필드는 변경하지 않으며, 비밀번호도 그대로 유지됩니다.
+		s.expect(http.StatusOK, http.MethodPatch, "/users/", alice.Token, map[string]any{"name": "Alice"}, &resp)
+		if resp.User.Name != "Alice" || resp.User.Language != "en" {
+			t.Fatalf("user = %+v", resp.User)
+		}
+		s.expect(http.StatusOK, http.MethodPost, "/login", "", handler.LoginRequest{Email: "alice@example.com", Password: "password"}, nil)
+
+		// null 은 값을 지웁니다.
+		s.expect(http.StatusOK, http.MethodPatch, "/users/", alice.Token, map[string]any{"language": nil}, &resp)
+		if resp.User.Language != "" {
+			t.Fatalf("language = %q", resp.User.Language)
+		}
+	})
+
+	t.Run("update user validation", func(t *testing.T) {
+		cases := []struct {
+			name string
+			body any
+			code string
+		}{
+			{"email", map[string]any{"email": "mallory@example.com"}, "read_only_field"},
+			{"password", map[string]any{"password": "hijacked"}, "read_only_field"},
+			{"company", map[string]any{"name": "Alice", "companyRole": "admin"}, "read_only_field"},
+			{"profile", map[string]any{"profile": "/static/uploads/other.png"}, "read_only_field"},
+			{"null name", map[string]any{"name": nil}, "invalid_user_name"},
+			{"blank name", map[string]any{"name": "  "}, "invalid_user_name"},
+			{"language", map[string]any{"language": "korean!"}, "invalid_language"},
+			{"not object", []string{"name"}, "invalid_request"},
+		}
+		for _, tc := range cases {
+			t.Run(tc.name, func(t *testing.T) {
+				var resp model.ErrorResponse
+				s.expect(http.StatusBadRequest, http.MethodPatch, "/users/", alice.Token, tc.body, &resp)
+				if resp.Code != tc.code {
+					t.Fatalf("code = %q, want %q", resp.Code, tc.code)
+				}
+			})
+		}
+
+		var user model.UserResponse
+		s.expect(http.StatusOK, http.MethodGet, "/users/", alice.Token, nil, &user)
+		if user.User.Name != "Alice" || user.User.Email != "alice@example.com" {
+			t.Fatalf("user = %+v", user.User)
+		}
+	})
+
+	t.Run("merge patch content type", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPatch, "/users/", strings.NewReader(`{"name":"Mallory"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+alice.Token)
+		w := httptest.NewRecorder()
+		s.router.ServeHTTP(w, req)
+		if w.Code != http.StatusUnsupportedMediaType || w.Header().Get("Accept-Patch") != "application/merge-patch+json" {
+			t.Fatalf("status = %d, Accept-Patch = %q", w.Code, w.Header().Get("Accept-Patch"))
+		}
+	})
+
+	t.Run("legacy put", func(t *testing.T) {
+		// 조회한 사용자 정보를 그대로 보내는 이전 클라이언트의 PUT 은 더 이상 반영하지 않습니다.
+		var failed model.ErrorResponse
+		s.expect(http.StatusMethodNotAllowed, http.MethodPut, "/users/", alice.Token, model.User{Name: "Mallory", Email: "mallory@example.com", Language: "ko"}, &failed)
+		if failed.Code != "method_deprecated" {
+			t.Fatalf("code = %q", failed.Code)
+		}
+
+		var user model.UserResponse
+		s.expect(http.StatusOK, http.MethodGet, "/users/", alice.Token, nil, &user)
+		if user.User.Name != "Alice" || user.User.Email != "alice@example.com" {
+			t.Fatalf("user = %+v", user.User)
+		}
+	})
+
+	t.Run("localized messages", func(t *testing.T) {
+		// 토큰의 언어(ko)가 Accept-Language 보다 우선합니다.
+		req := httptest.NewRequest(http.MethodGet, "/users/", nil)
+		req.Header.Set("Authorization", "Bearer "+alice.Token)
+		req.Header.Set("Accept-Language", "ja")
+		w := httptest.NewRecorder()
+		s.router.ServeHTTP(w, req)
+		var user model.UserResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &user); err != nil || user.Message != "사용자를 성공적으로 조회하였습니다" {
+			t.Fatalf("message = %q (%v)", user.Message, err)
+		}
+
+		// 인증되지 않은 요청은 Accept-Language 를 따릅니다.
+		req = httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(`{"email":"alice@example.com","password":"wrong"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Accept-Language", "fr-FR,ja;q=0.9,en;q=0.8")
+		w = httptest.NewRecorder()
+		s.router.ServeHTTP(w, req)
+		var failed model.ErrorResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &failed); err != nil || failed.Message != "メールアドレスまたはパスワードが正しくありません" {
+			t.Fatalf("message = %q (%v)", failed.Message, err)
+		}
+	})
+
+	t.Run("upload profile image", func(t *testing.T) {
+		t.Chdir(t.TempDir())
+		if err := os.MkdirAll("static/uploads", 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		var body bytes.Buffer
+		mw := multipart.NewWriter(&body)
+		part, err := mw.CreateFormFile("image", "avatar.png")
+		if err != nil {
+			t.Fatal(err)
+		}
+		part.Write([]byte("png"))
+		mw.Close()
+
+		req := httptest.NewRequest(http.MethodPost, "/users/profile-image", &body)
+		req.Header.Set("Content-Type", mw.FormDataContentType())
+		req.Header.Set("Authorization", "Bearer "+alice.Token)
+		w := httptest.NewRecorder()
+		s.router.ServeHTTP(w, req)
+		if w.Code != http.StatusOK {
+			t.Fatalf("status = %d (body: %s)", w.Code, w.Body.String())
+		}
+
+		var resp model.UserResponse
+		s.expect(http.StatusOK, http.MethodGet, "/users/", alice.Token, nil, &resp)
+		if want := "/static/uploads/" + alice.UserID + "_avatar.png"; resp.User.Profile != want {
+			t.Fatalf("profile = %q, want %q", resp.User.Profile, want)
+		}
+		if _, err := os.Stat("static/uploads/" + alice.UserID + "_avatar.png"); err != nil {
+			t.Fatalf("업로드한 파일이 없습니다: %v", err)
+		}
+
+		s.expect(http.StatusBadRequest, http.MethodPost, "/users/profile-image", alice.Token, nil, nil)
+	})
+
+	t.Run("devices", func(t *testing.T) {
+		var device model.DeviceResponse
+		s.expect(http.StatusOK, http.MethodPost, "/users/devices", alice.Token, model.RegisterDeviceModel{Platform: model.DevicePlatformIOS, PushToken: "token-1"}, &device)
+		s.expect(http.StatusOK, http.MethodPost, "/users/devices", alice.Token, model.RegisterDeviceModel{Platform: model.DevicePlatformAndroid, PushToken: "token-2"}, nil)
+		s.expect(http.StatusBadRequest, http.MethodPost, "/users/devices", alice.Token, model.RegisterDeviceModel{Platform: "desktop", PushToken: "token-3"}, nil)
+
+		var devices model.DevicesResponse
+		s.expect(http.StatusOK, http.MethodGet, "/users/devices", alice.Token, nil, &devices)
+		if len(devices.Devices) != 2 {
+			t.Fatalf("devices = %d, want 2", len(devices.Devices))
+		}
+
+		s.expect(http.StatusOK, http.MethodDelete, "/users/devices/"+device.Device.DeviceID, alice.Token, nil, nil)
+		s.expect(http.StatusNotFound, http.MethodDelete, "/users/devices/"+device.Device.DeviceID, alice.Token, nil, nil)
+
+		s.expect(http.StatusOK, http.MethodDelete, "/users/devices", alice.Token, nil, nil)
+		s.expect(http.StatusOK, http.MethodGet, "/users/devices", alice.Token, nil, &devices)
+		if len(devices.Devices) != 0 {
+			t.Fatalf("devices = %d, want 0", len(devices.Devices))
+		}
+	})
+
+	t.Run("refresh token rotation", func(t *testing.T) {
+		var resp model.RefreshTokenResponse
+		s.expect(http.StatusOK, http.MethodPost, "/token/refresh", "", handler.RefreshTokenRequest{RefreshToken: alice.RefreshToken}, &resp)
+		if resp.Token == "" || resp.RefreshToken == "" || resp.RefreshToken == alice.RefreshToken {
+			t.Fatalf("tokens = %+v", resp)
+		}
+		s.expect(http.StatusOK, http.MethodGet, "/users/", resp.Token, nil, nil)
+
+		// 이미 사용한 refresh token 을 다시 사용하면 같은 family 가 모두 폐기됩니다.
+		s.expect(http.StatusUnauthorized, http.MethodPost, "/token/refresh", "", handler.RefreshTokenRequest{RefreshToken: alice.RefreshToken}, nil)
+		s.expect(http.StatusUnauthorized, http.MethodPost, "/token/refresh", "", handler.RefreshTokenRequest{RefreshToken: resp.RefreshToken}, nil)
+		s.expect(http.StatusBadRequest, http.MethodPost, "/token/refresh", "", handler.RefreshTokenRequest{}, nil)
+	})
+
+	t.Run("logout", func(t *testing.T) {
+		bob := s.signUp("Bob", "bob@example.com", "en")
+		s.expect(http.StatusOK, http.MethodPost, "/logout", bob.Token, nil, nil)
+		s.expect(http.StatusUnauthorized, http.MethodGet, "/users/", bob.Token, nil, nil)
+		s.expect(http.StatusUnauthorized, http.MethodPost, "/token/refresh", "", handler.RefreshTokenRequest{RefreshToken: bob.RefreshToken}, nil)
+	})
+
+	t.Run("delete user", func(t *testing.T) {
+		carol := s.signUp("Carol", "carol@example.com", "ja")
+		s.expect(http.StatusOK, http.MethodDelete, "/users/", carol.Token, nil, nil)
+		// 탈퇴하면 현재 access token 과 모든 refresh token 이 폐기됩니다.
+		s.expect(http.StatusUnauthorized, http.MethodGet, "/users/", carol.Token, nil, nil)
+		s.expect(http.StatusUnauthorized, http.MethodPost, "/token/refresh", "", handler.RefreshTokenRequest{RefreshToken: carol.RefreshToken}, nil)
+	})
+}
+
+func TestDeleteUserChatRooms(t *testing.T) {
+	s := newTestServer(t)
+	alice := s.signUp("Alice", "alice@example.com", "ko")
+	bob := s.signUp("Bob", "bob@example.com", "en")
+	carol := s.signUp("Carol", "carol@example.com", "ja")
+	dave := s.signUp("Dave", "dave@example.com", "en")
+
+	// alice 가 소유한 채팅방은 관리자인 dave 에게 넘어가고, 혼자 있는 채팅방은 삭제됩니다.
+	owned := s.createChatRoom(alice, bob, carol)
+	ownedPath := "/chat-room/" + owned.ChatRoomID
+	s.expect(http.StatusCreated, http.MethodPost, ownedPath+"/members", alice.Token, model.ChatRoomMembersModel{UserIDs: []string{dave.UserID}, Role: model.ChatRoomRoleAdmin}, nil)
+	joined := s.createChatRoom(bob, alice)
+	alone := s.createChatRoom(alice)
+	s.expect(http.StatusCreated, http.MethodPost, ownedPath+"/messages", alice.Token, model.CreateMessageModel{Content: "안녕하세요", Language: "ko"}, nil)
+
+	s.expect(http.StatusOK, http.MethodDelete, "/users/", alice.Token, nil, nil)
+
+	var resp model.ChatRoomResponse
+	s.expect(http.StatusOK, http.MethodGet, ownedPath, bob.Token, nil, &resp)
+	if resp.ChatRoom.Owner.UserID != dave.UserID {
+		t.Fatalf("owner = %q, want %q", resp.ChatRoom.Owner.UserID, dave.UserID)
+	}
+	for _, m := range resp.ChatRoom.Members {
+		if m.User.UserID == alice.UserID {
+			t.Fatal("탈퇴한 사용자가 채팅방 멤버로 남았습니다")
+		}
+		if m.User.UserID == dave.UserID && m.Role != model.ChatRoomRoleOwner {
+			t.Fatalf("dave role = %q, want %q", m.Role, model.ChatRoomRoleOwner)
+		}
+	}
+	if len(resp.ChatRoom.Members) != 3 {
+		t.Fatalf("members = %d, want 3", len(resp.ChatRoom.Members))
+	}
+
+	s.expect(http.StatusOK, http.MethodGet, "/chat-room/"+joined.ChatRoomID, bob.Token, nil, &resp)
+	if resp.ChatRoom.Owner.UserID != bob.UserID || len(resp.ChatRoom.Members) != 1 {
+		t.Fatalf("chatRoom = %+v", resp.ChatRoom)
+	}
+	if _, err := (&memory.MemoryChatRoomRepository{Store: s.store}).FindByID(context.Background(), alone.ChatRoomID); !errors.Is(err, repository.ErrChatRoomNotFound) {
+		t.Fatalf("멤버가 없는 채팅방이 남았습니다: %v", err)
+	}
+
+	// 보낸 메세지는 다른 멤버를 위해 남고, sender 에는 userID 만 남습니다.
+	var messages model.MessagesResponse
+	s.expect(http.StatusOK, http.MethodGet, ownedPath+"/messages", carol.Token, nil, &messages)
+	if len(messages.Messages) != 1 || messages.Messages[0].Sender != (model.SenderSummary{UserID: alice.UserID}) {
+		t.Fatalf("messages = %+v", messages.Messages)
+	}
+
+	// 다른 사용자가 남은 회사의 마지막 관리자는 탈퇴할 수 없습니다.
+	var company model.CompanyResponse
+	s.expect(http.StatusCreated, http.MethodPost, "/companies/", bob.Token, model.CreateCompanyModel{Name: "Acme"}, &company)
+	var invitation model.CompanyInvitationResponse
+	s.expect(http.StatusCreated, http.MethodPost, "/companies/"+company.Company.CompanyID+"/invitations", bob.Token, model.CompanyMemberModel{Email: carol.Email}, &invitation)
+	s.expect(http.StatusOK, http.MethodPost, "/companies/invitations/"+invitation.Invitation.InvitationID+"/accept", carol.Token, nil, nil)
+	var failed model.ErrorResponse
+	s.expect(http.StatusConflict, http.MethodDelete, "/users/", bob.Token, nil, &failed)
+	if failed.Code != "last_company_admin" {
+		t.Fatalf("code = %q, want last_company_admin", failed.Code)
+	}
+	s.expect(http.StatusOK, http.MethodDelete, "/users/", carol.Token, nil, nil)
+	s.expect(http.StatusOK, http.MethodDelete, "/users/", bob.Token, nil, nil)
+}
+
+func TestAccountRoutes(t *testing.T) {
+	s := newTestServer(t)
+	alice := s.signUp("Alice", "alice@example.com", "ko")
+	s.signUp("Bob", "bob@example.com", "ko")
+
+	t.Run("change email", func(t *testing.T) {
+		var failed model.ErrorResponse
+		s.expect(http.StatusForbidden, http.MethodPost, "/users/email", alice.Token, model.ChangeEmailModel{Email: "alice@new.example.com", Password: "wrong"}, &failed)
+		if failed.Code != "incorrect_password" {
+			t.Fatalf("code = %q", failed.Code)
+		}
+		s.expect(http.StatusConflict, http.MethodPost, "/users/email", alice.Token, model.ChangeEmailModel{Email: "bob@example.com", Password: "password"}, nil)
+		s.expect(http.StatusBadRequest, http.MethodPost, "/users/email", alice.Token, model.ChangeEmailModel{Email: "not-an-email", Password: "password"}, nil)
+
+		s.expect(http.StatusAccepted, http.MethodPost, "/users/email", alice.Token, model.ChangeEmailModel{Email: "alice@old.example.com", Password: "password"}, nil)
+		stale := s.emails.token("alice@old.example.com")
+		s.expect(http.StatusAccepted, http.MethodPost, "/users/email", alice.Token, model.ChangeEmailModel{Email: "alice@new.example.com", Password: "password"}, nil)
+		token := s.emails.token("alice@new.example.com")
+		if stale == "" || token == "" {
+			t.Fatal("확인 토큰이 발송되지 않았습니다")
+		}
+
+		// 확인 전에는 이메일이 바뀌지 않습니다.
+		var user model.UserResponse
+		s.expect(http.StatusOK, http.MethodGet, "/users/", alice.Token, nil, &user)
+		if user.User.Email != "alice@example.com" {
+			t.Fatalf("email = %q", user.User.Email)
+		}
+
+		// 다시 요청하면 이전 토큰은 사용할 수 없습니다.
+		s.expect(http.StatusBadRequest, http.MethodPost, "/users/email/verify", "", model.VerifyEmailModel{Token: stale}, &failed)
+		if failed.Code != "invalid_email_token" {
+			t.Fatalf("code = %q", failed.Code)
+		}
+		s.expect(http.StatusOK, http.MethodPost, "/users/email/verify", "", model.VerifyEmailModel{Token: token}, &user)
+		if user.User.Email != "alice@new.example.com" {
+			t.Fatalf("email = %q", user.User.Email)
+		}
+		s.expect(http.StatusBadRequest, http.MethodPost, "/users/email/verify", "", model.VerifyEmailModel{Token: token}, nil)
+
+		s.expect(http.StatusOK, http.MethodPost, "/login", "", handler.LoginRequest{Email: "alice@new.example.com", Password: "password"}, nil)
+		s.expect(http.StatusUnauthorized, http.MethodPost, "/login", "", handler.LoginRequest{Email: "alice@example.com", Password: "password"}, nil)
+	})
+
+	t.Run("change password", func(t *testing.T) {
+		// 다른 기기에서 로그인한 세션
+		var other model.TokenResponse
+		s.expect(http.StatusOK, http.MethodPost, "/login", "", handler.LoginRequest{Email: "alice@new.example.com", Password: "password"}, &other)
+
+		var failed model.ErrorResponse
+		s.expect(http.StatusForbidden, http.MethodPut, "/users/password", alice.Token, model.ChangePasswordModel{CurrentPassword: "wrong", NewPassword: "new-password"}, &failed)
+		if failed.Code != "incorrect_password" {
+			t.Fatalf("code = %q", failed.Code)
+		}
+		s.expect(http.StatusBadRequest, http.MethodPut, "/users/password", alice.Token, model.ChangePasswordModel{CurrentPassword: "password", NewPassword: "short"}, &failed)
+		if failed.Code != "invalid_password" {
+			t.Fatalf("code = %q", failed.Code)
+		}
+
+		s.expect(http.StatusOK, http.MethodPut, "/users/password", alice.Token, model.ChangePasswordModel{CurrentPassword: "password", NewPassword: "new-password"}, nil)
+		s.expect(http.StatusUnauthorized, http.MethodPost, "/login", "", handler.LoginRequest{Email: "alice@new.example.com", Password: "password"}, nil)
+		s.expect(http.StatusOK, http.MethodPost, "/login", "", handler.LoginRequest{Email: "alice@new.example.com", Password: "new-password"}, nil)
+
+		// 다른 세션의 refresh token 은 폐기되고, 현재 세션은 유지됩니다.
+		s.expect(http.StatusUnauthorized, http.MethodPost, "/token/refresh", "", handler.RefreshTokenRequest{RefreshToken: other.RefreshToken}, nil)
+		s.expect(http.StatusOK, http.MethodPost, "/token/refresh", "", handler.RefreshTokenRequest{RefreshToken: alice.RefreshToken}, nil)
+	})
+}
+
+func TestChatRoomRoutes(t *testing.T) {
+	s := newTestServer(t)
+	alice := s.signUp("Alice", "alice@example.com", "ko")
+	bob := s.signUp("Bob", "bob@example.com", "en")
+	carol := s.signUp("Carol", "carol@example.com", "ja")
+
+	chatRoom := s.createChatRoom(alice, bob)
+	path := "/chat-room/" + chatRoom.ChatRoomID
+
+	t.Run("create with unknown user", func(t *testing.T) {
+		s.expect(http.StatusNotFound, http.MethodPost, "/chat-room/", alice.Token, model.CreateChatRoomModel{InviteUserIDS: []string{"unknown"}}, nil)
+	})
+
+	t.Run("get chat room", func(t *testing.T) {
+		var resp model.ChatRoomResponse
+		s.expect(http.StatusOK, http.MethodGet, path, bob.Token, nil, &resp)
+		if resp.ChatRoom.Owner.UserID != alice.UserID {
+			t.Fatalf("owner = %q, want %q", resp.ChatRoom.Owner.UserID, alice.UserID)
+		}
+		if len(resp.ChatRoom.Members) != 2 {
+			t.Fatalf("members = %d, want 2", len(resp.ChatRoom.Members))
+		}
+
+		s.expect(http.StatusForbidden, http.MethodGet, path, carol.Token, nil, nil)
+		s.expect(http.StatusNotFound, http.MethodGet, "/chat-room/unknown", alice.Token, nil, nil)
+	})
+
+	t.Run("list chat rooms", func(t *testing.T) {
+		var resp model.ChatRoomsResponse
+		s.expect(http.StatusOK, http.MethodGet, "/chat-rooms/", bob.Token, nil, &resp)
+		if len(resp.ChatRooms) != 1 || resp.ChatRooms[0].ChatRoomID != chatRoom.ChatRoomID {
+			t.Fatalf("chatRooms = %+v", resp.ChatRooms)
+		}
+
+		s.expect(http.StatusOK, http.MethodGet, "/chat-rooms/", carol.Token, nil, &resp)
+		if len(resp.ChatRooms) != 0 {
+			t.Fatalf("chatRooms = %d, want 0", len(resp.ChatRooms))
+		}
+	})
+
+	t.Run("members", func(t *testing.T) {
+		s.expect(http.StatusForbidden, http.MethodPost, path+"/members", bob.Token, model.ChatRoomMembersModel{UserIDs: []string{carol.UserID}}, nil)
+
+		var resp model.ChatRoomMembersResponse
+		s.expect(http.StatusCreated, http.MethodPost, path+"/members", alice.Token, model.ChatRoomMembersModel{UserIDs: []string{carol.UserID}}, &resp)
+		if len(resp.Members) != 3 {
+			t.Fatalf("members = %d, want 3", len(resp.Members))
+		}
+		s.expect(http.StatusOK, http.MethodGet, path, carol.Token, nil, nil)
+
+		s.expect(http.StatusForbidden, http.MethodDelete, path+"/members", alice.Token, model.ChatRoomMembersModel{UserIDs: []string{alice.UserID}}, nil)
+		s.expect(http.StatusOK, http.MethodDelete, path+"/members", alice.Token, model.ChatRoomMembersModel{UserIDs: []string{carol.UserID}}, nil)
+		s.expect(http.StatusForbidden, http.MethodGet, path, carol.Token, nil, nil)
+	})
+
+	t.Run("mute", func(t *testing.T) {
+		s.expect(http.StatusOK, http.MethodPut, path+"/mute", bob.Token, model.ChatRoomMuteModel{Muted: true}, nil)
+		s.expect(http.StatusForbidden, http.MethodPut, path+"/mute", carol.Token, model.ChatRoomMuteModel{Muted: true}, nil)
+
+		var resp model.ChatRoomResponse
+		s.expect(http.StatusOK, http.MethodGet, path, bob.Token, nil, &resp)
+		for _, m := range resp.ChatRoom.Members {
+			if m.UserID == bob.UserID && !m.Muted {
+				t.Fatal("bob 의 알림이 꺼지지 않았습니다")
+			}
+		}
+	})
+
+	t.Run("update chat room", func(t *testing.T) {
+		// 권한은 요청 body 가 아닌 저장된 멤버 역할로 확인합니다.
+		s.expect(http.StatusBadRequest, http.MethodPatch, path, bob.Token, map[string]any{"title": "Bob's room", "owner": map[string]any{"userID": bob.UserID}}, nil)
+		s.expect(http.StatusForbidden, http.MethodPatch, path, bob.Token, map[string]any{"title": "Bob's room"}, nil)
+		s.expect(http.StatusForbidden, http.MethodPatch, path, carol.Token, map[string]any{"title": "Carol's room"}, nil)
+		s.expect(http.StatusNotFound, http.MethodPatch, "/chat-room/unknown", alice.Token, map[string]any{"title": "Unknown"}, nil)
+
+		var resp model.ChatRoomResponse
+		s.expect(http.StatusOK, http.MethodPatch, path, alice.Token, map[string]any{"title": "Weekly sync", "description": "주간 회의", "defaultLanguage": "ko", "avatar": "https://example.com/room.png"}, &resp)
+		if resp.ChatRoom.Title != "Weekly sync" || resp.ChatRoom.DefaultLanguage != "ko" || resp.ChatRoom.Owner.UserID != alice.UserID {
+			t.Fatalf("chatRoom = %+v", resp.ChatRoom)
+		}
+
+		// 관리자도 수정할 수 있으며, 요청에 없는 필드는 변경하지 않고 null 은 값을 지웁니다.
+		dave := s.signUp("Dave", "dave@example.com", "ko")
+		s.expect(http.StatusCreated, http.MethodPost, path+"/members", alice.Token, model.ChatRoomMembersModel{UserIDs: []string{dave.UserID}, Role: model.ChatRoomRoleAdmin}, nil)
+		s.expect(http.StatusOK, http.MethodPatch, path, dave.Token, map[string]any{"description": nil}, &resp)
+		if resp.ChatRoom.Title != "Weekly sync" || resp.ChatRoom.Description != "" || resp.ChatRoom.Avatar != "https://example.com/room.png" {
+			t.Fatalf("chatRoom = %+v", resp.ChatRoom)
+		}
+		s.expect(http.StatusOK, http.MethodDelete, path+"/members", alice.Token, model.ChatRoomMembersModel{UserIDs: []string{dave.UserID}}, nil)
+
+		cases := []struct {
+			name string
+			body any
+			code string
+		}{
+			{"last message", map[string]any{"lastMessage": "spoofed"}, "read_only_field"},
+			{"created at", map[string]any{"title": "x", "createdAt": "2020-01-01T00:00:00Z"}, "read_only_field"},
+			{"members", map[string]any{"members": []any{}}, "read_only_field"},
+			{"title", map[string]any{"title": strings.Repeat("가", 101)}, "invalid_chat_room_title"},
+			{"language", map[string]any{"defaultLanguage": "한국어"}, "invalid_language"},
+			{"avatar", map[string]any{"avatar": "javascript:alert(1)"}, "invalid_chat_room_avatar"},
+		}
+		for _, tc := range cases {
+			t.Run(tc.name, func(t *testing.T) {
+				var failed model.ErrorResponse
+				s.expect(http.StatusBadRequest, http.MethodPatch, path, alice.Token, tc.body, &failed)
+				if failed.Code != tc.code {
+					t.Fatalf("code = %q, want %q", failed.Code, tc.code)
+				}
+			})
+		}
+
+		// 이전 클라이언트의 PUT 도 같은 규칙을 따르므로 application/json 본문은 받지 않습니다.
+		s.expect(http.StatusUnsupportedMediaType, http.MethodPut, path, alice.Token, model.ChatRoom{ChatRoomID: chatRoom.ChatRoomID}, nil)
+		s.expect(http.StatusOK, http.MethodGet, path, bob.Token, nil, &resp)
+		if resp.ChatRoom.Title != "Weekly sync" || !resp.ChatRoom.CreatedAt.Equal(chatRoom.CreatedAt) {
+			t.Fatalf("chatRoom = %+v", resp.ChatRoom)
+		}
+	})
+
+	t.Run("messages", func(t *testing.T) {
+		var posted model.MessageResponse
+		s.expect(http.StatusCreated, http.MethodPost, path+"/messages", alice.Token, model.CreateMessageModel{Content: "안녕하세요", Language: "ko"}, &posted)
+		s.expect(http.StatusCreated, http.MethodPost, path+"/messages", bob.Token, model.CreateMessageModel{Content: "Hello", Language: "en"}, nil)
+		s.expect(http.StatusBadRequest, http.MethodPost, path+"/messages", bob.Token, model.CreateMessageModel{Content: " "}, nil)
+		s.expect(http.StatusForbidden, http.MethodPost, path+"/messages", carol.Token, model.CreateMessageModel{Content: "hi"}, nil)
+
+		var page model.MessagesResponse
+		s.expect(http.StatusOK, http.MethodGet, path+"/messages?limit=1", bob.Token, nil, &page)
+		if len(page.Messages) != 1 || page.Messages[0].Content != "Hello" || page.NextCursor == "" {
+			t.Fatalf("page = %+v", page)
+		}
+		if sender := page.Messages[0].Sender; sender.UserID != bob.UserID || sender.Name != "Bob" {
+			t.Fatalf("sender = %+v", sender)
+		}
+		// 보낸 사용자의 이메일, 회사 소속 등은 다른 멤버에게 전달하지 않습니다.
+		if body := s.do(http.MethodGet, path+"/messages", alice.Token, nil).Body.String(); strings.Contains(body, bob.Email) || strings.Contains(body, "companyRole") {
+			t.Fatalf("메세지 응답에 보낸 사용자의 개인 정보가 포함되었습니다: %s", body)
+		}
+		s.expect(http.StatusOK, http.MethodGet, path+"/messages?limit=1&cursor="+page.NextCursor, bob.Token, nil, &page)
+		if len(page.Messages) != 1 || page.Messages[0].Content != "[ko→en] 안녕하세요" || page.NextCursor != "" {
+			t.Fatalf("page = %+v", page)
+		}
+		s.expect(http.StatusBadRequest, http.MethodGet, path+"/messages?cursor=unknown", bob.Token, nil, nil)
+		s.expect(http.StatusBadRequest, http.MethodGet, path+"/messages?limit=abc", bob.Token, nil, nil)
+
+		var message model.MessageResponse
+		s.expect(http.StatusOK, http.MethodGet, path+"/messages/"+posted.ChatMessage.MessageID+"?original=true", bob.Token, nil, &message)
+		if !message.ChatMessage.Translated || message.ChatMessage.OriginalContent != "안녕하세요" {
+			t.Fatalf("message = %+v", message.ChatMessage)
+		}
+		s.expect(http.StatusNotFound, http.MethodGet, path+"/messages/unknown", bob.Token, nil, nil)
+
+		var rooms model.ChatRoomsResponse
+		s.expect(http.StatusOK, http.MethodGet, "/chat-rooms/", alice.Token, nil, &rooms)
+		if rooms.ChatRooms[0].LastMessage != "Hello" {
+			t.Fatalf("lastMessage = %q, want %q", rooms.ChatRooms[0].LastMessage, "Hello")
+		}
+	})
+
+	t.Run("canceled request", func(t *testing.T) {
+		// 응답을 받기 전에 클라이언트가 연결을 끊은 요청은 메세지를 저장하지 않습니다.
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		req := httptest.NewRequest(http.MethodPost, path+"/messages", strings.NewReader(`{"content":"취소된 메세지"}`)).WithContext(ctx)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+alice.Token)
+		w := httptest.NewRecorder()
+		s.router.ServeHTTP(w, req)
+		if w.Code != 499 {
+			t.Fatalf("status = %d, want 499 (body: %s)", w.Code, w.Body.String())
+		}
+
+		var page model.MessagesResponse
+		s.expect(http.StatusOK, http.MethodGet, path+"/messages", alice.Token, nil, &page)
+		for _, m := range page.Messages {
+			if m.Content == "취소된 메세지" {
+				t.Fatalf("messages = %+v", page.Messages)
+			}
+		}
+	})
+
+	t.Run("delete chat room", func(t *testing.T) {
+		s.expect(http.StatusForbidden, http.MethodDelete, path, bob.Token, nil, nil)
+		s.expect(http.StatusOK, http.MethodDelete, path, alice.Token, nil, nil)
+		s.expect(http.StatusNotFound, http.MethodGet, path, alice.Token, nil, nil)
+		s.expect(http.StatusNotFound, http.MethodDelete, path, alice.Token, nil, nil)
+	})
+}
+
+func TestCompanyRoutes(t *testing.T) {
+	s := newTestServer(t)
+	alice := s.signUp("Alice", "alice@acme.com", "ko")
+	bob := s.signUp("Bob", "bob@acme.com", "en")
+	carol := s.signUp("Carol", "carol@example.com", "ja")
+
+	var created model.CompanyResponse
+	s.expect(http.StatusBadRequest, http.MethodPost, "/companies/", alice.Token, model.CreateCompanyModel{}, nil)
+	s.expect(http.StatusCreated, http.MethodPost, "/companies/", alice.Token, model.CreateCompanyModel{Name: "Acme", Domain: "acme.com"}, &created)
+	path := "/companies/" + created.Company.CompanyID
+
+	var company model.CompanyResponse
+	s.expect(http.StatusOK, http.MethodGet, path, alice.Token, nil, &company)
+	if company.Company.Name != "Acme" {
+		t.Fatalf("company = %+v", company.Company)
+	}
+	s.expect(http.StatusForbidden, http.MethodGet, path, bob.Token, nil, nil)
+
+	s.expect(http.StatusOK, http.MethodPut, path, alice.Token, model.CreateCompanyModel{Name: "Acme Corp", Domain: "acme.com"}, &company)
+	if company.Company.Name != "Acme Corp" {
+		t.Fatalf("company = %+v", company.Company)
+	}
+
+	// 초대는 관리자만 보낼 수 있고, 초대받은 사용자가 수락해야 소속됩니다.
+	var invited model.CompanyInvitationResponse
+	s.expect(http.StatusBadRequest, http.MethodPost, path+"/invitations", alice.Token, model.CompanyMemberModel{Email: "not an email"}, nil)
+	s.expect(http.StatusBadRequest, http.MethodPost, path+"/invitations", alice.Token, model.CompanyMemberModel{Email: "bob@acme.com", Role: "owner"}, nil)
+	s.expect(http.StatusForbidden, http.MethodPost, path+"/invitations", bob.Token, model.CompanyMemberModel{Email: "carol@example.com"}, nil)
+	s.expect(http.StatusCreated, http.MethodPost, path+"/invitations", alice.Token, model.CompanyMemberModel{Email: "bob@acme.com"}, &invited)
+	if invited.Invitation.Role != model.CompanyRoleMember || invited.Invitation.Company.Name != "Acme Corp" {
+		t.Fatalf("invitation = %+v", invited.Invitation)
+	}
+	s.expect(http.StatusForbidden, http.MethodGet, path, bob.Token, nil, nil)
+
+	var invitations model.CompanyInvitationsResponse
+	s.expect(http.StatusOK, http.MethodGet, "/companies/invitations", carol.Token, nil, &invitations)
+	if len(invitations.Invitations) != 0 {
+		t.Fatalf("invitations = %+v", invitations.Invitations)
+	}
+	s.expect(http.StatusOK, http.MethodGet, "/companies/invitations", bob.Token, nil, &invitations)
+	if len(invitations.Invitations) != 1 || invitations.Invitations[0].InvitationID != invited.Invitation.InvitationID {
+		t.Fatalf("invitations = %+v", invitations.Invitations)
+	}
+
+	accept := "/companies/invitations/" + invited.Invitation.InvitationID
+	s.expect(http.StatusNotFound, http.MethodPost, accept+"/accept", carol.Token, nil, nil)
+	var accepted model.UserResponse
+	s.expect(http.StatusOK, http.MethodPost, accept+"/accept", bob.Token, nil, &accepted)
+	if accepted.User.CompanyID != created.Company.CompanyID || accepted.User.CompanyRole != model.CompanyRoleMember {
+		t.Fatalf("user = %+v", accepted.User)
+	}
+	s.expect(http.StatusNotFound, http.MethodPost, accept+"/accept", bob.Token, nil, nil)
+	s.expect(http.StatusConflict, http.MethodPost, path+"/invitations", alice.Token, model.CompanyMemberModel{Email: "bob@acme.com"}, nil)
+
+	var users model.UsersResponse
+	s.expect(http.StatusOK, http.MethodGet, path+"/users", bob.Token, nil, &users)
+	if len(users.Users) != 2 {
+		t.Fatalf("users = %d, want 2", len(users.Users))
+	}
+	s.expect(http.StatusOK, http.MethodGet, path+"/users?q=BOB", bob.Token, nil, &users)
+	if len(users.Users) != 1 || users.Users[0].UserID != bob.UserID {
+		t.Fatalf("users = %+v", users.Users)
+	}
+	s.expect(http.StatusForbidden, http.MethodGet, path+"/users", carol.Token, nil, nil)
+
+	// 초대받은 사용자는 거절하고, 회사 관리자는 취소할 수 있습니다.
+	s.expect(http.StatusCreated, http.MethodPost, path+"/invitations", alice.Token, model.CompanyMemberModel{Email: "carol@example.com"}, &invited)
+	s.expect(http.StatusNotFound, http.MethodDelete, "/companies/invitations/"+invited.Invitation.InvitationID, bob.Token, nil, nil)
+	s.expect(http.StatusOK, http.MethodDelete, "/companies/invitations/"+invited.Invitation.InvitationID, carol.Token, nil, nil)
+	s.expect(http.StatusCreated, http.MethodPost, path+"/invitations", alice.Token, model.CompanyMemberModel{Email: "carol@example.com"}, &invited)
+	s.expect(http.StatusOK, http.MethodDelete, "/companies/invitations/"+invited.Invitation.InvitationID, alice.Token, nil, nil)
+	s.expect(http.StatusNotFound, http.MethodPost, "/companies/invitations/"+invited.Invitation.InvitationID+"/accept", carol.Token, nil, nil)
+
+	// 역할 변경도 마지막 관리자를 남겨 둡니다.
+	members := path + "/members/"
+	s.expect(http.StatusForbidden, http.MethodPut, members+alice.UserID, bob.Token, model.CompanyRoleModel{Role: model.CompanyRoleMember}, nil)
+	s.expect(http.StatusBadRequest, http.MethodPut, members+bob.UserID, alice.Token, model.CompanyRoleModel{Role: "owner"}, nil)
+	s.expect(http.StatusNotFound, http.MethodPut, members+carol.UserID, alice.Token, model.CompanyRoleModel{Role: model.CompanyRoleAdmin}, nil)
+	s.expect(http.StatusConflict, http.MethodPut, members+alice.UserID, alice.Token, model.CompanyRoleModel{Role: model.CompanyRoleMember}, nil)
+	s.expect(http.StatusOK, http.MethodPut, members+bob.UserID, alice.Token, model.CompanyRoleModel{Role: model.CompanyRoleAdmin}, &accepted)
+	if accepted.User.CompanyRole != model.CompanyRoleAdmin {
+		t.Fatalf("user = %+v", accepted.User)
+	}
+	s.expect(http.StatusOK, http.MethodPut, members+alice.UserID, alice.Token, model.CompanyRoleModel{Role: model.CompanyRoleMember}, nil)
+	s.expect(http.StatusConflict, http.MethodPut, members+bob.UserID, bob.Token, model.CompanyRoleModel{Role: model.CompanyRoleMember}, nil)
+
+	s.expect(http.StatusConflict, http.MethodDelete, members+bob.UserID, bob.Token, nil, nil)
+	s.expect(http.StatusForbidden, http.MethodDelete, members+bob.UserID, alice.Token, nil, nil)
+	s.expect(http.StatusOK, http.MethodDelete, members+alice.UserID, bob.Token, nil, nil)
+	s.expect(http.StatusForbidden, http.MethodGet, path, alice.Token, nil, nil)
+}
+
+func TestGlossaryRoutes(t *testing.T) {
+	s := newTestServer(t)
+	alice := s.signUp("Alice", "alice@acme.com", "ko")
+	bob := s.signUp("Bob", "bob@acme.com", "en")
+	carol := s.signUp("Carol", "carol@example.com", "ja")
+
+	var company model.CompanyResponse
+	s.expect(http.StatusCreated, http.MethodPost, "/companies/", alice.Token, model.CreateCompanyModel{Name: "Acme"}, &company)
+	var invited model.CompanyInvitationResponse
+	s.expect(http.StatusCreated, http.MethodPost, "/companies/"+company.Company.CompanyID+"/invitations", alice.Token, model.CompanyMemberModel{Email: "bob@acme.com"}, &invited)
+	s.expect(http.StatusOK, http.MethodPost, "/companies/invitations/"+invited.Invitation.InvitationID+"/accept", bob.Token, nil, nil)
+	chatRoom := s.createChatRoom(alice, bob)
+
+	scopes := map[string]string{
+		"company":   "/companies/" + company.Company.CompanyID,
+		"chat room": "/chat-room/" + chatRoom.ChatRoomID,
+	}
+	for name, base := range scopes {
+		t.Run(name, func(t *testing.T) {
+			path := base + "/glossary"
+
+			var created model.GlossaryTermResponse
+			term := model.GlossaryTermModel{Source: "브리저", Targets: map[string]string{"en": "Bridger"}}
+			s.expect(http.StatusCreated, http.MethodPost, path, alice.Token, term, &created)
+			s.expect(http.StatusConflict, http.MethodPost, path, alice.Token, term, nil)
+			s.expect(http.StatusBadRequest, http.MethodPost, path, alice.Token, model.GlossaryTermModel{}, nil)
+			s.expect(http.StatusForbidden, http.MethodPost, path, bob.Token, model.GlossaryTermModel{Source: "회의", Targets: map[string]string{"en": "meeting"}}, nil)
+
+			var terms model.GlossaryTermsResponse
+			s.expect(http.StatusOK, http.MethodGet, path, bob.Token, nil, &terms)
+			if len(terms.Terms) != 1 || terms.Terms[0].Source != "브리저" {
+				t.Fatalf("terms = %+v", terms.Terms)
+			}
+			s.expect(http.StatusForbidden, http.MethodGet, path, carol.Token, nil, nil)
+
+			var updated model.GlossaryTermResponse
+			termPath := path + "/" + created.Term.TermID
+			s.expect(http.StatusOK, http.MethodPut, termPath, alice.Token, model.GlossaryTermModel{Source: "브리저", Targets: map[string]string{"en": "B-Bridger", "ja": "ブリッジャー"}}, &updated)
+			if target, _ := updated.Term.TargetFor("ja"); target != "ブリッジャー" {
+				t.Fatalf("term = %+v", updated.Term)
+			}
+			s.expect(http.StatusNotFound, http.MethodPut, path+"/unknown", alice.Token, term, nil)
+
+			w := s.do(http.MethodGet, path+"/export", bob.Token, nil)
+			if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), "B-Bridger") {
+				t.Fatalf("export: status = %d (body: %s)", w.Code, w.Body.String())
+			}
+
+			var imported model.GlossaryImportResponse
+			csv := []byte("source,caseSensitive,doNotTranslate,en\n브리저,false,false,Bridger\n회의,false,false,meeting\n")
+			s.expect(http.StatusOK, http.MethodPost, path+"/import", alice.Token, csv, &imported)
+			if imported.Imported != 2 {
+				t.Fatalf("imported = %d, want 2", imported.Imported)
+			}
+			s.expect(http.StatusBadRequest, http.MethodPost, path+"/import", alice.Token, []byte("en\nBridger\n"), nil)
+			s.expect(http.StatusOK, http.MethodGet, path, bob.Token, nil, &terms)
+			if len(terms.Terms) != 2 {
+				t.Fatalf("terms = %d, want 2", len(terms.Terms))
+			}
+
+			s.expect(http.StatusForbidden, http.MethodDelete, termPath, bob.Token, nil, nil)
+			s.expect(http.StatusOK, http.MethodDelete, termPath, alice.Token, nil, nil)
+			s.expect(http.StatusNotFound, http.MethodDelete, termPath, alice.Token, nil, nil)
+		})
+	}
+}
+
+func TestWebSocketRoute(t *testing.T) {
+	s := newTestServer(t)
+	alice := s.signUp("Alice", "alice@example.com", "ko")
+	bob := s.signUp("Bob", "bob@example.com", "en")
+	carol := s.signUp("Carol", "carol@example.com", "ja")
+	chatRoom := s.createChatRoom(alice, bob)
+
+	server := httptest.NewServer(s.router)
+	defer server.Close()
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/chat-room/" + chatRoom.ChatRoomID + "/ws?token="
+
+	if _, resp, err := websocket.DefaultDialer.Dial(url, nil); err == nil || resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("토큰 없이 연결되었습니다: %v", err)
+	}
+	if _, resp, err := websocket.DefaultDialer.Dial(url+carol.Token, nil); err == nil || resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("멤버가 아닌 사용자가 연결되었습니다: %v", err)
+	}
+
+	// 허용하지 않은 Origin 의 페이지에서는 토큰이 있어도 연결할 수 없습니다.
+	protocol := http.Header{"Sec-WebSocket-Protocol": {model.WebSocketProtocol + ", " + model.WebSocketTokenProtocolPrefix + bob.Token}}
+	foreign := protocol.Clone()
+	foreign.Set("Origin", "https://evil.example.com")
+	if _, resp, err := websocket.DefaultDialer.Dial(strings.TrimSuffix(url, "?token="), foreign); err == nil || resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("허용하지 않은 Origin 에서 연결되었습니다: %v", err)
+	}
+
+	// 같은 호스트의 페이지는 Sec-WebSocket-Protocol 로 토큰을 전달하며, 응답에는 토큰이 포함되지 않습니다.
+	protocol.Set("Origin", server.URL)
+	conn, resp, err := websocket.DefaultDialer.Dial(strings.TrimSuffix(url, "?token="), protocol)
+	if err != nil {
+		t.Fatalf("연결 실패: %v", err)
+	}
+	defer conn.Close()
+	if got := resp.Header.Get("Sec-WebSocket-Protocol"); got != model.WebSocketProtocol {
+		t.Fatalf("subprotocol = %q, want %q", got, model.WebSocketProtocol)
+	}
+
+	// readEvent는 주어진 종류의 이벤트가 올 때까지 읽습니다.
+	readEvent := func(eventType string) model.ChatEvent {
+		t.Helper()
+		conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		for {
+			var event model.ChatEvent
+			if err := conn.ReadJSON(&event); err != nil {
+				t.Fatalf("이벤트 수신 실패: %v", err)
+			}
+			if event.Type == eventType {
+				return event
+			}
+		}
+	}
+
+	readEvent(model.ChatEventJoin)
+
+	// REST 로 보낸 메세지도 수신자의 언어로 전달됩니다.
+	s.expect(http.StatusCreated, http.MethodPost, "/chat-room/"+chatRoom.ChatRoomID+"/messages", alice.Token, model.CreateMessageModel{Content: "안녕하세요", Language: "ko"}, nil)
+	event := readEvent(model.ChatEventMessage)
+	if event.ChatMessage == nil || event.ChatMessage.Content != "[ko→en] 안녕하세요" {
+		t.Fatalf("event = %+v", event)
+	}
+
+	if err := conn.WriteJSON(model.ChatCommand{Type: model.ChatEventMessage, Content: "Hello", Language: "en"}); err != nil {
+		t.Fatal(err)
+	}
+	event = readEvent(model.ChatEventMessage)
+	if event.ChatMessage == nil || event.ChatMessage.Content != "Hello" || event.UserID != bob.UserID {
+		t.Fatalf("event = %+v", event)
+	}
+
+	if err := conn.WriteJSON(model.ChatCommand{Type: "typing"}); err != nil {
+		t.Fatal(err)
+	}
+	if event := readEvent(model.ChatEventError); event.Detail != "unknown event type" {
+		t.Fatalf("event = %+v", event)
+	}
+
+	if body := s.do(http.MethodGet, "/metrics", "", nil).Body.String(); !strings.Contains(body, "bridger_websocket_connections 1\n") {
+		t.Fatalf("WebSocket 연결 수 지표가 없습니다:\n%s", body)
+	}
+
+	// 서버가 종료되면 close 메세지를 받고, 이후에는 연결할 수 없습니다.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.hub.Shutdown(ctx); err != nil {
+		t.Fatalf("shutdown: %v", err)
+	}
+	if s.hub.Count(chatRoom.ChatRoomID) != 0 {
+		t.Fatal("종료 후에도 클라이언트가 남아 있습니다")
+	}
+	late, _, err := websocket.DefaultDialer.Dial(url+bob.Token, nil)
+	if err != nil {
+		t.Fatalf("연결 실패: %v", err)
+	}
+	defer late.Close()
+	late.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if _, _, err := late.ReadMessage(); err == nil {
+		t.Fatal("종료 후 연결이 유지되었습니다")
+	}
+}
+
+func TestWebSocketRemovedMember(t *testing.T) {
+	s := newTestServer(t)
+	alice := s.signUp("Alice", "alice@example.com", "ko")
+	bob := s.signUp("Bob", "bob@example.com", "en")
+	carol := s.signUp("Carol", "carol@example.com", "ja")
+	chatRoom := s.createChatRoom(alice, bob, carol)
+	path := "/chat-room/" + chatRoom.ChatRoomID
+
+	server := httptest.NewServer(s.router)
+	defer server.Close()
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + path + "/ws?token="
+
+	connect := func(user *testUser) *websocket.Conn {
+		t.Helper()
+		conn, _, err := websocket.DefaultDialer.Dial(url+user.Token, nil)
+		if err != nil {
+			t.Fatalf("연결 실패: %v", err)
+		}
+		t.Cleanup(func() { conn.Close() })
+		deadline := time.Now().Add(5 * time.Second)
+		for !s.hub.IsConnected(chatRoom.ChatRoomID, user.UserID) {
+			if time.Now().After(deadline) {
+				t.Fatal("Hub 에 등록되지 않았습니다")
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		return conn
+	}
+	// readUntilClosed는 연결이 닫힐 때까지 읽고, 받은 이벤트를 반환합니다.
+	readUntilClosed := func(conn *websocket.Conn) []model.ChatEvent {
+		t.Helper()
+		conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		var events []model.ChatEvent
+		for {
+			var event model.ChatEvent
+			if err := conn.ReadJSON(&event); err != nil {
+				if !websocket.IsCloseError(err, websocket.CloseNoStatusReceived) {
+					t.Fatalf("close 메세지 대신 %v", err)
+				}
+				return events
+			}
+			events = append(events, event)
+		}
+	}
+
+	// 내보낸 멤버의 연결은 끊기고, 이후의 메세지를 받지 않습니다.
+	bobConn := connect(bob)
+	s.expect(http.StatusOK, http.MethodDelete, path+"/members", alice.Token, model.ChatRoomMembersModel{UserIDs: []string{bob.UserID}}, nil)
+	readUntilClosed(bobConn)
+	if s.hub.IsConnected(chatRoom.ChatRoomID, bob.UserID) {
+		t.Fatal("내보낸 멤버가 채팅방에 접속해 있습니다")
+	}
+
+	// 다른 서버에서 내보내져 연결이 남아 있어도 메세지를 보낼 수 없습니다.
+	carolConn := connect(carol)
+	if err := (&memory.MemoryChatRoomMemberRepository{Store: s.store}).RemoveMembers(context.Background(), chatRoom.ChatRoomID, []string{carol.UserID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := carolConn.WriteJSON(model.ChatCommand{Type: model.ChatEventMessage, Content: "こんにちは", Language: "ja"}); err != nil {
+		t.Fatal(err)
+	}
+	var rejected bool
+	for _, event := range readUntilClosed(carolConn) {
+		if event.Type == model.ChatEventMessage {
+			t.Fatalf("내보낸 멤버의 메세지가 전달되었습니다: %+v", event)
+		}
+		rejected = rejected || event.Type == model.ChatEventError && event.Detail == "not a chat room member"
+	}
+	if !rejected {
+		t.Fatal("not a chat room member 오류를 받지 못했습니다")
+	}
+
+	var page model.MessagesResponse
+	s.expect(http.StatusOK, http.MethodGet, path+"/messages", alice.Token, nil, &page)
+	if len(page.Messages) != 0 {
+		t.Fatalf("messages = %+v", page.Messages)
+	}
+}
+
+func TestHealthRoutes(t *testing.T) {
+	s := newTestServer(t)
+
+	s.expect(http.StatusOK, http.MethodGet, "/healthz", "", nil, nil)
+
+	var version model.VersionResponse
+	s.expect(http.StatusOK, http.MethodGet, "/version", "", nil, &version)
+	if version.GoVersion == "" || version.Commit == "" || version.BuildTime == "" {
+		t.Fatalf("version = %+v", version)
+	}
+
+	var dbErr error
+	s.health.Checks = []service.HealthCheck{
+		{Name: "database", Check: func(context.Context) error { return dbErr }},
+		{Name: "translation", Optional: true, Check: func(context.Context) error { return errors.New("unreachable") }},
+	}
+
+	// 선택 검사가 실패해도 준비된 상태입니다.
+	var ready model.ReadinessResponse
+	s.expect(http.StatusOK, http.MethodGet, "/readyz", "", nil, &ready)
+	if !ready.Ready || len(ready.Checks) != 2 || ready.Checks[0].Status != model.HealthStatusOK || ready.Checks[1].Status != model.HealthStatusFail {
+		t.Fatalf("readyz = %+v", ready)
+	}
+
+	dbErr = errors.New("connection refused")
+	s.expect(http.StatusServiceUnavailable, http.MethodGet, "/readyz", "", nil, &ready)
+	if ready.Ready || ready.Checks[0].Status != model.HealthStatusFail {
+		t.Fatalf("readyz = %+v", ready)
+	}
+	// 인증 없이 호출되므로 실패 원인은 응답에 포함하지 않습니다.
+	if body := s.do(http.MethodGet, "/readyz", "", nil).Body.String(); strings.Contains(body, "connection refused") {
+		t.Fatalf("실패 원인이 응답에 포함되었습니다: %s", body)
+	}
+
+	// 종료 중에는 검사 결과와 관계없이 실패합니다.
+	dbErr = nil
+	s.health.Drain()
+	s.expect(http.StatusServiceUnavailable, http.MethodGet, "/readyz", "", nil, &ready)
+	if ready.Ready {
+		t.Fatalf("readyz = %+v", ready)
+	}
+	s.expect(http.StatusOK, http.MethodGet, "/healthz", "", nil, nil)
+}
+
+func TestMetricsRoute(t *testing.T) {
+	s := newTestServer(t)
+	alice := s.signUp("Alice", "alice@example.com", "ko")
+	bob := s.signUp("Bob", "bob@example.com", "en")
+	chatRoom := s.createChatRoom(alice, bob)
+
+	s.expect(http.StatusOK, http.MethodGet, "/chat-room/"+chatRoom.ChatRoomID, alice.Token, nil, nil)
+	s.expect(http.StatusCreated, http.MethodPost, "/chat-room/"+chatRoom.ChatRoomID+"/messages", alice.Token, model.CreateMessageModel{Content: "안녕하세요", Language: "ko"}, nil)
+	s.expect(http.StatusNotFound, http.MethodGet, "/no-such-path/"+chatRoom.ChatRoomID, "", nil, nil)
+
+	w := s.do(http.MethodGet, "/metrics", "", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d", w.Code)
+	}
+	body := w.Body.String()
+	for _, want := range []string{
+		// 경로의 ID 대신 라우트 템플릿으로 기록합니다.
+		`bridger_http_requests_total{method="GET",route="/chat-room/:id",status="200"} 1`,
+		`bridger_http_requests_total{method="GET",route="unmatched",status="404"} 1`,
+		`bridger_http_request_duration_seconds_count{method="POST",route="/chat-room/:id/messages"} 1`,
+		`bridger_translation_requests_total{outcome="ok",provider="local",source_language="ko",target_language="en"} 1`,
+		`bridger_translation_request_duration_seconds_count{provider="local",source_language="ko",target_language="en"} 1`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("지표가 없습니다: %s", want)
+		}
+	}
+	if strings.Contains(body, chatRoom.ChatRoomID) {
+		t.Error("지표 라벨에 채팅방 ID 가 포함되었습니다")
+	}
+}
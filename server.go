@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/B-Bridger/server/config"
+)
+
+// shutdownStep은 서버 종료 시 HTTP 서버를 멈춘 뒤 순서대로 실행하는 정리 작업입니다.
+type shutdownStep struct {
+	name string
+	fn   func(ctx context.Context) error
+}
+
+// newHTTPServer는 설정의 timeout 을 적용한 HTTP 서버를 생성합니다.
+func newHTTPServer(cfg config.Server, handler http.Handler) *http.Server {
+	return &http.Server{
+		Addr:              ":" + cfg.Port,
+		Handler:           handler,
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+		ReadTimeout:       cfg.ReadTimeout,
+		WriteTimeout:      cfg.WriteTimeout,
+		IdleTimeout:       cfg.IdleTimeout,
+	}
+}
+
+// serve는 ctx 가 끝날 때까지(종료 신호) 요청을 처리한 뒤 서버를 종료합니다.
+//
+// 종료 신호를 받으면 drain 을 호출하고(readiness 실패) cfg.ShutdownDelay 만큼 요청을 계속 처리합니다.
+// 이후 새로운 연결을 받지 않고 처리 중인 요청을 기다린 다음, steps 를 순서대로 실행합니다.
+// 모든 대기는 cfg.ShutdownTimeout 안에서 이루어지며,
+// 기한이 지나도 남은 단계는 건너뛰지 않고 실행하여 자원을 정리합니다.
+//
+// 매개 변수
+//   - ctx: 종료 신호를 받으면 끝나는 context
+//   - srv: HTTP 서버
+//   - ln: 요청을 받을 listener
+//   - cfg: 서버 설정 (ShutdownTimeout, ShutdownDelay)
+//   - drain: 종료 신호를 받으면 가장 먼저 호출됩니다 (nil 가능)
+//   - steps: HTTP 서버를 멈춘 뒤 실행할 정리 작업
+//
+// 반환 값
+//   - error: 서버 실행 또는 종료 중 발생한 error 메세지
+func serve(ctx context.Context, srv *http.Server, ln net.Listener, cfg config.Server, drain func(), steps ...shutdownStep) error {
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- srv.Serve(ln)
+	}()
+
+	select {
+	case err := <-serveErr:
+		return err
+	case <-ctx.Done():
+	}
+
+	if drain != nil {
+		drain()
+	}
+	if cfg.ShutdownDelay > 0 {
+		slog.Info("서버를 종료합니다. 지연 시간 후 새로운 연결을 받지 않습니다.", slog.Duration("delay", cfg.ShutdownDelay))
+		time.Sleep(cfg.ShutdownDelay)
+	}
+
+	slog.Info("서버를 종료합니다. 처리 중인 요청을 기다립니다.", slog.Duration("timeout", cfg.ShutdownTimeout))
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+
+	var errs []error
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		errs = append(errs, fmt.Errorf("HTTP 서버 종료: %w", err))
+	}
+	if err := <-serveErr; !errors.Is(err, http.ErrServerClosed) {
+		errs = append(errs, err)
+	}
+	for _, step := range steps {
+		if err := step.fn(shutdownCtx); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", step.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
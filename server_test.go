@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/B-Bridger/server/config"
+)
+
+func TestServeGracefulShutdown(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	srv := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		io.WriteString(w, "ok")
+	})}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var steps []string
+	done := make(chan error, 1)
+	go func() {
+		done <- serve(ctx, srv, ln, config.Server{ShutdownTimeout: 5 * time.Second}, func() { steps = append(steps, "drain") },
+			shutdownStep{name: "first", fn: func(context.Context) error { steps = append(steps, "first"); return nil }},
+			shutdownStep{name: "second", fn: func(context.Context) error { steps = append(steps, "second"); return nil }},
+		)
+	}()
+
+	// 처리 중인 요청이 있는 상태에서 종료 신호를 보냅니다.
+	response := make(chan string, 1)
+	go func() {
+		resp, err := http.Get("http://" + ln.Addr().String())
+		if err != nil {
+			response <- err.Error()
+			return
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		response <- string(body)
+	}()
+	<-started
+	cancel()
+
+	select {
+	case err := <-done:
+		t.Fatalf("처리 중인 요청을 기다리지 않고 종료되었습니다: %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+	if _, err := net.DialTimeout("tcp", ln.Addr().String(), time.Second); err == nil {
+		t.Fatal("종료 중에 새로운 연결을 받았습니다")
+	}
+
+	close(release)
+	if body := <-response; body != "ok" {
+		t.Fatalf("response = %q", body)
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(steps, ",") != "drain,first,second" {
+		t.Fatalf("steps = %v", steps)
+	}
+}
@@ -1,71 +1,485 @@
 package service
 
 import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+
+	"github.com/B-Bridger/server/apperror"
 	"github.com/B-Bridger/server/model"
 	"github.com/B-Bridger/server/repository"
+	"github.com/B-Bridger/server/tracing"
+)
+
+var (
+	ErrNotChatRoomMember  = apperror.New(apperror.KindForbidden, "not_chat_room_member", "채팅방 멤버가 아닙니다")
+	ErrNotChatRoomOwner   = apperror.New(apperror.KindForbidden, "not_chat_room_owner", "채팅방 소유자가 아닙니다")
+	ErrNoPermission       = apperror.New(apperror.KindForbidden, "permission_denied", "권한이 없습니다")
+	ErrInvalidRole        = apperror.New(apperror.KindValidation, "invalid_role", "역할이 올바르지 않습니다")
+	ErrRemoveOwner        = apperror.New(apperror.KindForbidden, "cannot_remove_owner", "채팅방 소유자는 내보낼 수 없습니다")
+	ErrUserNotFound       = repository.ErrUserNotFound
+	ErrInvalidTitle       = apperror.New(apperror.KindValidation, "invalid_chat_room_title", "채팅방 제목은 100자 이하여야 합니다")
+	ErrInvalidDescription = apperror.New(apperror.KindValidation, "invalid_chat_room_description", "채팅방 설명은 500자 이하여야 합니다")
+	ErrInvalidAvatar      = apperror.New(apperror.KindValidation, "invalid_chat_room_avatar", "채팅방 이미지 주소가 올바르지 않습니다")
+)
+
+// 채팅방 설정 길이 제한 (글자 수)
+const (
+	maxChatRoomTitleLength       = 100
+	maxChatRoomDescriptionLength = 500
+	maxChatRoomAvatarLength      = 255
 )
 
+// MemberDisconnector는 채팅방에서 나간 사용자의 실시간 연결을 끊는 역할을 추상화합니다.
+type MemberDisconnector interface {
+	Disconnect(chatRoomID, userID string)
+}
+
 // ChatRoomService는 채팅방 도메인과 관련된 비즈니스 로직을 담당합니다.
 //
 // Methods:
+//   - GetChatRoomByID (채팅방 조회)
+//   - GetChatRoomForMember (멤버 권한 확인 후 채팅방 조회)
+//   - GetChatRoomsByMember (참여 중인 채팅방 목록 조회)
+//   - CreateChatRoom (채팅방 생성 및 초대)
+//   - AddMembers, RemoveMembers (멤버 관리)
+//   - SetMuted (알림 끄기 설정)
+//   - UpdateChatRoom (채팅방 설정 수정)
 type ChatRoomService struct {
-	Repo repository.ChatRoomRepository
+	Repo       repository.ChatRoomRepository
+	MemberRepo repository.ChatRoomMemberRepository
+	UserRepo   repository.UserRepository
+	// 여러 저장소에 걸친 변경(멤버 추가 등)을 하나의 트랜잭션으로 실행합니다.
+	UnitOfWork repository.UnitOfWork
+	// 내보낸 멤버의 WebSocket 연결을 끊습니다. nil 이면 생략합니다.
+	Connections MemberDisconnector
 }
 
 // ChatRoomID를 통해 ChatRoom 객체를 반환합니다.
 //
 // 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
 //   - id: 채팅방의 고유 ID
 //
 // 반환 값
 //   - ChatRoom: 불러온 ChatRoom 객체
 //   - error: 실패 시 error 메세지
-func (s *ChatRoomService) GetChatRoomByID(id string) (*model.ChatRoom, error) {
-	return s.Repo.FindByID(id)
+func (s *ChatRoomService) GetChatRoomByID(ctx context.Context, id string) (_ *model.ChatRoom, err error) {
+	ctx, span := tracing.Start(ctx, "ChatRoomService.GetChatRoomByID")
+	defer func() { tracing.End(span, err) }()
+
+	return s.Repo.FindByID(ctx, id)
+}
+
+// GetChatRoomForMember는 사용자가 채팅방 멤버인 경우에만 ChatRoom 객체를 반환합니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - id: 채팅방의 고유 ID
+//   - userID: 사용자의 고유 ID
+//
+// 반환 값
+//   - ChatRoom: 불러온 ChatRoom 객체
+//   - error: 채팅방이 없으면 조회 error, 멤버가 아니면 ErrNotChatRoomMember
+func (s *ChatRoomService) GetChatRoomForMember(ctx context.Context, id, userID string) (_ *model.ChatRoom, err error) {
+	ctx, span := tracing.Start(ctx, "ChatRoomService.GetChatRoomForMember")
+	defer func() { tracing.End(span, err) }()
+
+	chatRoom, err := s.Repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.findMember(ctx, id, userID); err != nil {
+		return nil, err
+	}
+
+	return chatRoom, nil
 }
 
 // OwnerUserID를 통해 ChatRoom 객체를 반환합니다.
 //
 // 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
 //   - id: 사용자의 고유 ID
 //
 // 반환 값
 //   - ChatRoom: 불러온 ChatRoom 객체
 //   - error: 실패 시 error 메세지
-func (s *ChatRoomService) GetChatRoomByUserID(id string) (*[]model.ChatRoom, error) {
-	return s.Repo.FindByOwner(id)
+func (s *ChatRoomService) GetChatRoomByUserID(ctx context.Context, id string) (_ *[]model.ChatRoom, err error) {
+	ctx, span := tracing.Start(ctx, "ChatRoomService.GetChatRoomByUserID")
+	defer func() { tracing.End(span, err) }()
+
+	return s.Repo.FindByOwner(ctx, id)
 }
 
-// chatRoom 객체를 데이터베이스에 저장합니다.
+// 사용자가 멤버로 참여 중인 ChatRoom 목록을 반환합니다.
 //
 // 매개 변수
-//   - chatRoom: ChatRoom 객체 포인터
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - id: 사용자의 고유 ID
 //
 // 반환 값
+//   - []ChatRoom: 불러온 ChatRoom 목록
 //   - error: 실패 시 error 메세지
-func (s *ChatRoomService) CreateChatRoom(chatRoom *model.ChatRoom) error {
-	return s.Repo.Create(chatRoom)
+func (s *ChatRoomService) GetChatRoomsByMember(ctx context.Context, id string) (_ *[]model.ChatRoom, err error) {
+	ctx, span := tracing.Start(ctx, "ChatRoomService.GetChatRoomsByMember")
+	defer func() { tracing.End(span, err) }()
+
+	return s.Repo.FindByMember(ctx, id)
 }
 
-// chatRoom 객체를 데이터베이스 수정합니다.
+// 채팅방을 생성하고 초대한 사용자를 멤버로 추가합니다.
+// 생성한 사용자는 owner, 초대한 사용자는 member 역할을 가지며,
+// 멤버들이 소속된 회사가 채팅방 참여 회사로 기록됩니다.
 //
 // 매개 변수
-//   - chatRoom: ChatRoom 객체 포인터
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - ownerID: 채팅방을 생성하는 사용자의 고유 ID
+//   - inviteUserIDs: 초대할 사용자의 고유 ID 목록
+//
+// 반환 값
+//   - ChatRoom: 생성된 ChatRoom 객체
+//   - error: 존재하지 않는 사용자가 있거나 실패 시 error 메세지
+func (s *ChatRoomService) CreateChatRoom(ctx context.Context, ownerID string, inviteUserIDs []string) (_ *model.ChatRoom, err error) {
+	ctx, span := tracing.Start(ctx, "ChatRoomService.CreateChatRoom")
+	defer func() { tracing.End(span, err) }()
+
+	owner, err := s.UserRepo.FindByID(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	invitees, err := s.resolveUsers(ctx, ownerID, inviteUserIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	chatRoom := model.ChatRoom{
+		UserID:  ownerID,
+		Members: []model.ChatRoomMember{{UserID: ownerID, Role: model.ChatRoomRoleOwner}},
+	}
+	for _, u := range invitees {
+		chatRoom.Members = append(chatRoom.Members, model.ChatRoomMember{UserID: u.UserID, Role: model.ChatRoomRoleMember})
+	}
+	for _, companyID := range companyIDs(append(invitees, *owner)) {
+		chatRoom.Companies = append(chatRoom.Companies, model.ChatRoomCompany{CompanyID: companyID})
+	}
+
+	if err := s.Repo.Create(ctx, &chatRoom); err != nil {
+		return nil, err
+	}
+
+	return s.Repo.FindByID(ctx, chatRoom.ChatRoomID)
+}
+
+// AddMembers는 채팅방에 멤버를 추가합니다.
+// owner, admin 만 초대할 수 있으며 admin 역할은 owner 만 부여할 수 있습니다.
+// 새로운 멤버의 소속 회사도 같은 트랜잭션 안에서 채팅방 참여 회사로 기록됩니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - chatRoomID: 채팅방의 고유 ID
+//   - actorID: 요청한 사용자의 고유 ID
+//   - req: 추가할 사용자 ID 목록과 역할
+//
+// 반환 값
+//   - []ChatRoomMember: 변경 후 전체 멤버 목록
+//   - error: 실패 시 error 메세지
+func (s *ChatRoomService) AddMembers(ctx context.Context, chatRoomID, actorID string, req *model.ChatRoomMembersModel) (_ []model.ChatRoomMember, err error) {
+	ctx, span := tracing.Start(ctx, "ChatRoomService.AddMembers")
+	defer func() { tracing.End(span, err) }()
+
+	actor, err := s.findMember(ctx, chatRoomID, actorID)
+	if err != nil {
+		return nil, err
+	}
+	if !actor.CanManageMembers() {
+		return nil, ErrNoPermission
+	}
+
+	role := req.Role
+	switch role {
+	case "":
+		role = model.ChatRoomRoleMember
+	case model.ChatRoomRoleMember:
+	case model.ChatRoomRoleAdmin:
+		if actor.Role != model.ChatRoomRoleOwner {
+			return nil, ErrNoPermission
+		}
+	default:
+		return nil, ErrInvalidRole
+	}
+
+	users, err := s.resolveUsers(ctx, actorID, req.UserIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]model.ChatRoomMember, 0, len(users))
+	for _, u := range users {
+		members = append(members, model.ChatRoomMember{ChatRoomID: chatRoomID, UserID: u.UserID, Role: role})
+	}
+	err = s.UnitOfWork.Do(ctx, func(ctx context.Context, repos *repository.Repositories) error {
+		if err := repos.ChatRoomMembers.AddMembers(ctx, members); err != nil {
+			return err
+		}
+		return repos.ChatRooms.AddCompanies(ctx, chatRoomID, companyIDs(users))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.MemberRepo.FindMembers(ctx, chatRoomID)
+}
+
+// RemoveMembers는 채팅방에서 멤버를 내보냅니다.
+// 누구나 자기 자신은 내보낼 수 있고(퇴장), 다른 멤버는 owner, admin 만 내보낼 수 있습니다.
+// admin 은 다른 admin 을 내보낼 수 없으며, owner 는 내보낼 수 없습니다.
+// 내보낸 멤버가 채팅방 WebSocket 에 접속 중이면 연결을 끊어 더 이상 이벤트를 받지 않도록 합니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - chatRoomID: 채팅방의 고유 ID
+//   - actorID: 요청한 사용자의 고유 ID
+//   - userIDs: 내보낼 사용자의 고유 ID 목록
 //
 // 반환 값
-//   - ChatRoom: 수정된 ChatRoom 객체
 //   - error: 실패 시 error 메세지
-func (s *ChatRoomService) UpdateChatRoom(chatRoom *model.ChatRoom) (*model.ChatRoom, error) {
-	return s.Repo.Update(chatRoom)
+func (s *ChatRoomService) RemoveMembers(ctx context.Context, chatRoomID, actorID string, userIDs []string) (err error) {
+	ctx, span := tracing.Start(ctx, "ChatRoomService.RemoveMembers")
+	defer func() { tracing.End(span, err) }()
+
+	actor, err := s.findMember(ctx, chatRoomID, actorID)
+	if err != nil {
+		return err
+	}
+
+	members, err := s.MemberRepo.FindMembers(ctx, chatRoomID)
+	if err != nil {
+		return err
+	}
+	roles := make(map[string]string, len(members))
+	for _, m := range members {
+		roles[m.UserID] = m.Role
+	}
+
+	for _, id := range userIDs {
+		role, ok := roles[id]
+		switch {
+		case !ok:
+			continue
+		case role == model.ChatRoomRoleOwner:
+			return ErrRemoveOwner
+		case id == actorID:
+			continue
+		case !actor.CanManageMembers():
+			return ErrNoPermission
+		case role == model.ChatRoomRoleAdmin && actor.Role != model.ChatRoomRoleOwner:
+			return ErrNoPermission
+		}
+	}
+
+	if err := s.MemberRepo.RemoveMembers(ctx, chatRoomID, userIDs); err != nil {
+		return err
+	}
+	if s.Connections != nil {
+		for _, id := range userIDs {
+			if _, ok := roles[id]; ok {
+				s.Connections.Disconnect(chatRoomID, id)
+			}
+		}
+	}
+	return nil
+}
+
+// SetMuted는 요청한 사용자의 채팅방 푸시 알림 끄기 설정을 변경합니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - chatRoomID: 채팅방의 고유 ID
+//   - userID: 요청한 사용자의 고유 ID
+//   - muted: true 이면 알림을 받지 않습니다
+//
+// 반환 값
+//   - error: 멤버가 아니면 ErrNotChatRoomMember, 실패 시 error 메세지
+func (s *ChatRoomService) SetMuted(ctx context.Context, chatRoomID, userID string, muted bool) (err error) {
+	ctx, span := tracing.Start(ctx, "ChatRoomService.SetMuted")
+	defer func() { tracing.End(span, err) }()
+
+	if _, err := s.findMember(ctx, chatRoomID, userID); err != nil {
+		return err
+	}
+	return s.MemberRepo.SetMuted(ctx, chatRoomID, userID, muted)
+}
+
+// findMember는 채팅방 멤버를 불러옵니다. 멤버가 아니면 ErrNotChatRoomMember 를 반환합니다.
+func (s *ChatRoomService) findMember(ctx context.Context, chatRoomID, userID string) (*model.ChatRoomMember, error) {
+	member, err := s.MemberRepo.FindMember(ctx, chatRoomID, userID)
+	if errors.Is(err, repository.ErrChatRoomMemberNotFound) {
+		return nil, ErrNotChatRoomMember
+	}
+	return member, err
+}
+
+// resolveUsers는 중복과 요청자 본인을 제외한 사용자 목록을 불러옵니다.
+// 존재하지 않는 사용자가 있으면 error 를 반환합니다.
+func (s *ChatRoomService) resolveUsers(ctx context.Context, actorID string, userIDs []string) ([]model.User, error) {
+	seen := map[string]struct{}{actorID: {}}
+	var ids []string
+	for _, id := range userIDs {
+		if _, ok := seen[id]; ok || id == "" {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	users, err := s.UserRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(users) != len(ids) {
+		found := make(map[string]struct{}, len(users))
+		for _, u := range users {
+			found[u.UserID] = struct{}{}
+		}
+		var missing []string
+		for _, id := range ids {
+			if _, ok := found[id]; !ok {
+				missing = append(missing, id)
+			}
+		}
+		return nil, ErrUserNotFound.WithDetail("%s", strings.Join(missing, ", "))
+	}
+
+	return users, nil
+}
+
+// companyIDs는 사용자들이 소속된 회사의 고유 ID 목록을 중복 없이 반환합니다.
+func companyIDs(users []model.User) []string {
+	seen := make(map[string]struct{})
+	var ids []string
+	for _, u := range users {
+		if u.CompanyID == "" {
+			continue
+		}
+		if _, ok := seen[u.CompanyID]; ok {
+			continue
+		}
+		seen[u.CompanyID] = struct{}{}
+		ids = append(ids, u.CompanyID)
+	}
+	return ids
+}
+
+// UpdateChatRoom은 요청에 포함된 채팅방 설정만 수정합니다. (JSON merge patch)
+// 권한은 요청 본문이 아닌 저장된 멤버 역할로 확인하며, 소유자와 관리자만 수정할 수 있습니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - chatRoomID: 채팅방의 고유 ID
+//   - actorID: 요청한 사용자의 고유 ID
+//   - req: 변경할 설정
+//
+// 반환 값
+//   - ChatRoom: 수정된 ChatRoom 객체
+//   - error: 멤버가 아니면 ErrNotChatRoomMember, 소유자나 관리자가 아니면 ErrNoPermission, 값이 올바르지 않으면 validation error
+func (s *ChatRoomService) UpdateChatRoom(ctx context.Context, chatRoomID, actorID string, req *model.UpdateChatRoomModel) (_ *model.ChatRoom, err error) {
+	ctx, span := tracing.Start(ctx, "ChatRoomService.UpdateChatRoom")
+	defer func() { tracing.End(span, err) }()
+
+	var updated *model.ChatRoom
+	err = s.UnitOfWork.Do(ctx, func(ctx context.Context, repos *repository.Repositories) error {
+		chatRoom, err := repos.ChatRooms.FindByID(ctx, chatRoomID)
+		if err != nil {
+			return err
+		}
+		actor, err := repos.ChatRoomMembers.FindMember(ctx, chatRoomID, actorID)
+		if errors.Is(err, repository.ErrChatRoomMemberNotFound) {
+			return ErrNotChatRoomMember
+		}
+		if err != nil {
+			return err
+		}
+		if !actor.CanManageMembers() {
+			return ErrNoPermission
+		}
+
+		if err := applyChatRoomPatch(chatRoom, req); err != nil {
+			return err
+		}
+		if err := repos.ChatRooms.UpdateSettings(ctx, chatRoom); err != nil {
+			return err
+		}
+		updated = chatRoom
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// applyChatRoomPatch는 요청 값을 검증하여 chatRoom 에 반영합니다.
+func applyChatRoomPatch(chatRoom *model.ChatRoom, req *model.UpdateChatRoomModel) error {
+	if req.Title.Set {
+		title := strings.TrimSpace(req.Title.Value)
+		if len([]rune(title)) > maxChatRoomTitleLength {
+			return ErrInvalidTitle
+		}
+		chatRoom.Title = title
+	}
+	if req.Description.Set {
+		description := strings.TrimSpace(req.Description.Value)
+		if len([]rune(description)) > maxChatRoomDescriptionLength {
+			return ErrInvalidDescription
+		}
+		chatRoom.Description = description
+	}
+	if req.DefaultLanguage.Set {
+		language := strings.TrimSpace(req.DefaultLanguage.Value)
+		if !req.DefaultLanguage.Null && !languagePattern.MatchString(language) {
+			return ErrInvalidLanguage.WithDetail("%q", req.DefaultLanguage.Value)
+		}
+		chatRoom.DefaultLanguage = language
+	}
+	if req.Avatar.Set {
+		avatar := strings.TrimSpace(req.Avatar.Value)
+		if !req.Avatar.Null && !validAvatar(avatar) {
+			return ErrInvalidAvatar.WithDetail("%q", req.Avatar.Value)
+		}
+		chatRoom.Avatar = avatar
+	}
+	return nil
+}
+
+// validAvatar는 avatar 가 http(s) URL 이거나 업로드한 이미지 경로인지 반환합니다.
+func validAvatar(avatar string) bool {
+	if avatar == "" || len(avatar) > maxChatRoomAvatarLength {
+		return false
+	}
+	if strings.HasPrefix(avatar, "/static/uploads/") {
+		return !strings.Contains(avatar, "..")
+	}
+	u, err := url.Parse(avatar)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
 }
 
 // chatRoom 객체를 데이터베이스에서 제거합니다.
 //
 // 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
 //   - id: 채팅방의 고유 ID
 //
 // 반환 값
 //   - error: 실패 시 error 메세지
-func (s *ChatRoomService) DeleteChatRoom(id string) error {
-	return s.Repo.Delete(id)
+func (s *ChatRoomService) DeleteChatRoom(ctx context.Context, id string) (err error) {
+	ctx, span := tracing.Start(ctx, "ChatRoomService.DeleteChatRoom")
+	defer func() { tracing.End(span, err) }()
+
+	return s.Repo.Delete(ctx, id)
 }
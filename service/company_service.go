@@ -0,0 +1,447 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/B-Bridger/server/apperror"
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/repository"
+	"github.com/B-Bridger/server/tracing"
+)
+
+var (
+	ErrAlreadyInCompany = apperror.New(apperror.KindConflict, "already_in_company", "이미 회사에 소속된 사용자입니다")
+	ErrNotCompanyMember = apperror.New(apperror.KindForbidden, "not_company_member", "회사 소속이 아닙니다")
+	ErrLastCompanyAdmin = apperror.New(apperror.KindConflict, "last_company_admin", "마지막 회사 관리자는 내보내거나 역할을 바꿀 수 없습니다")
+	ErrEmptyCompanyName = apperror.New(apperror.KindValidation, "empty_company_name", "회사 이름이 비어있습니다")
+
+	ErrCompanyInvitationNotFound = repository.ErrCompanyInvitationNotFound
+)
+
+// 회사 초대의 유효 기간
+const companyInvitationTTL = 7 * 24 * time.Hour
+
+// CompanyService는 회사(tenant) 도메인과 관련된 비즈니스 로직을 담당합니다.
+// 권한 확인은 토큰이 아닌 데이터베이스의 현재 소속 정보를 기준으로 합니다.
+//
+// Methods:
+//   - CreateCompany (회사 생성)
+//   - GetCompany, UpdateCompany (회사 조회, 수정)
+//   - GetDirectory (회사 사용자 목록 조회)
+//   - InviteMember, GetInvitations, AcceptInvitation, DeleteInvitation (회사 초대)
+//   - UpdateMemberRole, RemoveMember (소속 관리)
+type CompanyService struct {
+	Repo     repository.CompanyRepository
+	UserRepo repository.UserRepository
+	// 초대 수락 등 여러 저장소에 걸친 변경을 하나의 트랜잭션으로 실행합니다.
+	UnitOfWork repository.UnitOfWork
+}
+
+// CreateCompany는 회사를 생성하고 요청한 사용자를 회사 관리자로 지정합니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - actorID: 요청한 사용자의 고유 ID
+//   - req: 회사 정보
+//
+// 반환 값
+//   - *Company: 생성된 Company 객체
+//   - error: 이미 회사에 소속되어 있거나 실패 시 error 메세지
+func (s *CompanyService) CreateCompany(ctx context.Context, actorID string, req *model.CreateCompanyModel) (_ *model.Company, err error) {
+	ctx, span := tracing.Start(ctx, "CompanyService.CreateCompany")
+	defer func() { tracing.End(span, err) }()
+
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, ErrEmptyCompanyName
+	}
+
+	actor, err := s.UserRepo.FindByID(ctx, actorID)
+	if err != nil {
+		return nil, err
+	}
+	if actor.CompanyID != "" {
+		return nil, ErrAlreadyInCompany
+	}
+
+	company := model.Company{Name: req.Name, Domain: req.Domain}
+	if err := s.Repo.Create(ctx, &company, actorID); err != nil {
+		return nil, err
+	}
+
+	return &company, nil
+}
+
+// GetCompany는 요청한 사용자가 소속된 경우에만 회사 정보를 반환합니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - id: 회사의 고유 ID
+//   - actorID: 요청한 사용자의 고유 ID
+//
+// 반환 값
+//   - *Company: 불러온 Company 객체
+//   - error: 소속이 아니면 ErrNotCompanyMember
+func (s *CompanyService) GetCompany(ctx context.Context, id, actorID string) (_ *model.Company, err error) {
+	ctx, span := tracing.Start(ctx, "CompanyService.GetCompany")
+	defer func() { tracing.End(span, err) }()
+
+	if _, err := s.authorize(ctx, id, actorID, false); err != nil {
+		return nil, err
+	}
+	return s.Repo.FindByID(ctx, id)
+}
+
+// UpdateCompany는 회사 정보를 수정합니다. 회사 관리자만 수정할 수 있습니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - id: 회사의 고유 ID
+//   - actorID: 요청한 사용자의 고유 ID
+//   - req: 수정할 회사 정보
+//
+// 반환 값
+//   - *Company: 수정된 Company 객체
+//   - error: 실패 시 error 메세지
+func (s *CompanyService) UpdateCompany(ctx context.Context, id, actorID string, req *model.CreateCompanyModel) (_ *model.Company, err error) {
+	ctx, span := tracing.Start(ctx, "CompanyService.UpdateCompany")
+	defer func() { tracing.End(span, err) }()
+
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, ErrEmptyCompanyName
+	}
+	if _, err := s.authorize(ctx, id, actorID, true); err != nil {
+		return nil, err
+	}
+
+	company, err := s.Repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	company.Name = req.Name
+	company.Domain = req.Domain
+
+	return s.Repo.Update(ctx, company)
+}
+
+// GetDirectory는 같은 회사 사용자 목록을 반환합니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - id: 회사의 고유 ID
+//   - actorID: 요청한 사용자의 고유 ID
+//   - query: 이름, 이메일 검색어
+//
+// 반환 값
+//   - []User: 불러온 user 목록
+//   - error: 소속이 아니면 ErrNotCompanyMember
+func (s *CompanyService) GetDirectory(ctx context.Context, id, actorID, query string) (_ []model.User, err error) {
+	ctx, span := tracing.Start(ctx, "CompanyService.GetDirectory")
+	defer func() { tracing.End(span, err) }()
+
+	if _, err := s.authorize(ctx, id, actorID, false); err != nil {
+		return nil, err
+	}
+	return s.Repo.FindUsers(ctx, id, strings.TrimSpace(query))
+}
+
+// InviteMember는 이메일로 회사 초대를 보냅니다. 회사 관리자만 초대할 수 있습니다.
+// 초대받은 사용자가 AcceptInvitation 으로 수락해야 회사에 소속되며, 같은 이메일을 다시 초대하면 이전 초대를 대체합니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - id: 회사의 고유 ID
+//   - actorID: 요청한 사용자의 고유 ID
+//   - req: 초대할 사용자의 이메일과 역할
+//
+// 반환 값
+//   - *CompanyInvitation: 생성된 초대
+//   - error: 이미 회사에 소속된 사용자이면 ErrAlreadyInCompany, 실패 시 error 메세지
+func (s *CompanyService) InviteMember(ctx context.Context, id, actorID string, req *model.CompanyMemberModel) (_ *model.CompanyInvitation, err error) {
+	ctx, span := tracing.Start(ctx, "CompanyService.InviteMember")
+	defer func() { tracing.End(span, err) }()
+
+	if _, err := s.authorize(ctx, id, actorID, true); err != nil {
+		return nil, err
+	}
+
+	role := req.Role
+	if role == "" {
+		role = model.CompanyRoleMember
+	}
+	if !validCompanyRole(role) {
+		return nil, ErrInvalidRole
+	}
+	email := strings.TrimSpace(req.Email)
+	if address, err := mail.ParseAddress(email); err != nil || address.Address != email {
+		return nil, ErrInvalidEmail
+	}
+
+	invitation := model.CompanyInvitation{CompanyID: id, Email: email, Role: role, InvitedBy: actorID, ExpiresAt: time.Now().Add(companyInvitationTTL)}
+	err = s.UnitOfWork.Do(ctx, func(ctx context.Context, repos *repository.Repositories) error {
+		user, err := repos.Users.FindByEmail(ctx, email)
+		switch {
+		case err == nil && user.CompanyID == id:
+			return ErrAlreadyInCompany
+		case err != nil && !errors.Is(err, repository.ErrUserNotFound):
+			return err
+		}
+
+		pending, err := repos.Companies.FindInvitationsByEmail(ctx, email)
+		if err != nil {
+			return err
+		}
+		for _, p := range pending {
+			if p.CompanyID != id {
+				continue
+			}
+			if err := repos.Companies.DeleteInvitation(ctx, p.InvitationID); err != nil {
+				return err
+			}
+		}
+		return repos.Companies.CreateInvitation(ctx, &invitation)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Repo.FindInvitation(ctx, invitation.InvitationID)
+}
+
+// GetInvitations는 요청한 사용자의 이메일로 받은, 만료되지 않은 회사 초대 목록을 반환합니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - actorID: 요청한 사용자의 고유 ID
+//
+// 반환 값
+//   - []CompanyInvitation: 불러온 초대 목록
+//   - error: 실패 시 error 메세지
+func (s *CompanyService) GetInvitations(ctx context.Context, actorID string) (_ []model.CompanyInvitation, err error) {
+	ctx, span := tracing.Start(ctx, "CompanyService.GetInvitations")
+	defer func() { tracing.End(span, err) }()
+
+	actor, err := s.UserRepo.FindByID(ctx, actorID)
+	if err != nil {
+		return nil, err
+	}
+	invitations, err := s.Repo.FindInvitationsByEmail(ctx, actor.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	pending := make([]model.CompanyInvitation, 0, len(invitations))
+	for _, invitation := range invitations {
+		if invitation.ExpiresAt.After(now) {
+			pending = append(pending, invitation)
+		}
+	}
+	return pending, nil
+}
+
+// AcceptInvitation은 요청한 사용자의 이메일로 받은 초대를 수락하여 초대한 회사에 소속됩니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - invitationID: 초대의 고유 ID
+//   - actorID: 요청한 사용자의 고유 ID
+//
+// 반환 값
+//   - *User: 소속이 변경된 user 객체
+//   - error: 본인이 받은 유효한 초대가 아니면 ErrCompanyInvitationNotFound, 이미 회사에 소속되어 있으면 ErrAlreadyInCompany
+func (s *CompanyService) AcceptInvitation(ctx context.Context, invitationID, actorID string) (_ *model.User, err error) {
+	ctx, span := tracing.Start(ctx, "CompanyService.AcceptInvitation")
+	defer func() { tracing.End(span, err) }()
+
+	var user *model.User
+	err = s.UnitOfWork.Do(ctx, func(ctx context.Context, repos *repository.Repositories) error {
+		actor, err := repos.Users.FindByID(ctx, actorID)
+		if err != nil {
+			return err
+		}
+		invitation, err := repos.Companies.FindInvitation(ctx, invitationID)
+		if err != nil {
+			return err
+		}
+		// 다른 사용자의 초대가 있는지 알 수 없도록 찾을 수 없는 것으로 처리합니다.
+		if !strings.EqualFold(invitation.Email, actor.Email) || !invitation.ExpiresAt.After(time.Now()) {
+			return ErrCompanyInvitationNotFound
+		}
+		if actor.CompanyID != "" {
+			return ErrAlreadyInCompany
+		}
+
+		if err := repos.Companies.SetMembership(ctx, actorID, invitation.CompanyID, invitation.Role); err != nil {
+			return err
+		}
+		if err := repos.Companies.DeleteInvitation(ctx, invitationID); err != nil {
+			return err
+		}
+		actor.CompanyID = invitation.CompanyID
+		actor.CompanyRole = invitation.Role
+		user = actor
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// DeleteInvitation은 회사 초대를 삭제합니다.
+// 초대받은 사용자는 거절할 수 있고, 초대한 회사의 관리자는 취소할 수 있습니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - invitationID: 초대의 고유 ID
+//   - actorID: 요청한 사용자의 고유 ID
+//
+// 반환 값
+//   - error: 삭제할 수 있는 초대가 아니면 ErrCompanyInvitationNotFound, 실패 시 error 메세지
+func (s *CompanyService) DeleteInvitation(ctx context.Context, invitationID, actorID string) (err error) {
+	ctx, span := tracing.Start(ctx, "CompanyService.DeleteInvitation")
+	defer func() { tracing.End(span, err) }()
+
+	actor, err := s.UserRepo.FindByID(ctx, actorID)
+	if err != nil {
+		return err
+	}
+	invitation, err := s.Repo.FindInvitation(ctx, invitationID)
+	if err != nil {
+		return err
+	}
+
+	invitee := strings.EqualFold(invitation.Email, actor.Email)
+	admin := actor.CompanyID == invitation.CompanyID && actor.CompanyRole == model.CompanyRoleAdmin
+	if !invitee && !admin {
+		return ErrCompanyInvitationNotFound
+	}
+	return s.Repo.DeleteInvitation(ctx, invitationID)
+}
+
+// UpdateMemberRole은 회사 사용자의 역할을 변경합니다. 회사 관리자만 변경할 수 있습니다.
+// 마지막 남은 관리자는 다른 역할로 변경할 수 없습니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - id: 회사의 고유 ID
+//   - actorID: 요청한 사용자의 고유 ID
+//   - userID: 역할을 변경할 사용자의 고유 ID
+//   - role: 변경할 역할 (admin, member)
+//
+// 반환 값
+//   - *User: 역할이 변경된 user 객체
+//   - error: 마지막 관리자이면 ErrLastCompanyAdmin, 실패 시 error 메세지
+func (s *CompanyService) UpdateMemberRole(ctx context.Context, id, actorID, userID, role string) (_ *model.User, err error) {
+	ctx, span := tracing.Start(ctx, "CompanyService.UpdateMemberRole")
+	defer func() { tracing.End(span, err) }()
+
+	if !validCompanyRole(role) {
+		return nil, ErrInvalidRole
+	}
+	if _, err := s.authorize(ctx, id, actorID, true); err != nil {
+		return nil, err
+	}
+
+	// 관리자 수 확인과 역할 변경 사이에 다른 관리자가 변경되지 않도록 한 트랜잭션에서 처리합니다.
+	var user *model.User
+	err = s.UnitOfWork.Do(ctx, func(ctx context.Context, repos *repository.Repositories) error {
+		member, err := repos.Users.FindByID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if member.CompanyID != id {
+			return ErrUserNotFound
+		}
+		if member.CompanyRole != role {
+			if err := checkLastAdmin(ctx, repos.Companies, id, userID); err != nil {
+				return err
+			}
+			if err := repos.Companies.SetMembership(ctx, userID, id, role); err != nil {
+				return err
+			}
+			member.CompanyRole = role
+		}
+		user = member
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// RemoveMember는 사용자의 회사 소속을 해제합니다.
+// 자기 자신은 누구나 해제할 수 있고, 다른 사용자는 회사 관리자만 해제할 수 있습니다.
+// 마지막 남은 관리자는 해제할 수 없습니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - id: 회사의 고유 ID
+//   - actorID: 요청한 사용자의 고유 ID
+//   - userID: 소속을 해제할 사용자의 고유 ID
+//
+// 반환 값
+//   - error: 실패 시 error 메세지
+func (s *CompanyService) RemoveMember(ctx context.Context, id, actorID, userID string) (err error) {
+	ctx, span := tracing.Start(ctx, "CompanyService.RemoveMember")
+	defer func() { tracing.End(span, err) }()
+
+	if _, err := s.authorize(ctx, id, actorID, actorID != userID); err != nil {
+		return err
+	}
+
+	return s.UnitOfWork.Do(ctx, func(ctx context.Context, repos *repository.Repositories) error {
+		user, err := repos.Users.FindByID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if user.CompanyID != id {
+			return ErrUserNotFound
+		}
+		if err := checkLastAdmin(ctx, repos.Companies, id, userID); err != nil {
+			return err
+		}
+		return repos.Companies.SetMembership(ctx, userID, "", "")
+	})
+}
+
+// checkLastAdmin은 userID 가 회사의 마지막 관리자이면 ErrLastCompanyAdmin 을 반환합니다.
+// 관리자의 소속을 해제하거나 역할을 변경하기 전에 같은 UnitOfWork 안에서 호출하며,
+// 관리자 행을 잠근 뒤의 목록으로 판단하므로 동시에 다른 관리자를 변경하는 요청과 경합하지 않습니다.
+func checkLastAdmin(ctx context.Context, companies repository.CompanyRepository, companyID, userID string) error {
+	admins, err := companies.LockAdmins(ctx, companyID)
+	if err != nil {
+		return err
+	}
+	if len(admins) == 1 && admins[0] == userID {
+		return ErrLastCompanyAdmin
+	}
+	return nil
+}
+
+// validCompanyRole은 회사 내 역할로 사용할 수 있는 값인지 반환합니다.
+func validCompanyRole(role string) bool {
+	return role == model.CompanyRoleAdmin || role == model.CompanyRoleMember
+}
+
+// authorize는 사용자가 회사에 소속되어 있는지, requireAdmin 이면 관리자인지 확인합니다.
+func (s *CompanyService) authorize(ctx context.Context, id, actorID string, requireAdmin bool) (*model.User, error) {
+	actor, err := s.UserRepo.FindByID(ctx, actorID)
+	if err != nil {
+		return nil, err
+	}
+	if actor.CompanyID == "" || actor.CompanyID != id {
+		return nil, ErrNotCompanyMember
+	}
+	if requireAdmin && actor.CompanyRole != model.CompanyRoleAdmin {
+		return nil, ErrNoPermission
+	}
+	return actor, nil
+}
@@ -0,0 +1,89 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/repository/memory"
+	"github.com/B-Bridger/server/service"
+)
+
+// 두 관리자가 동시에 스스로 관리자 역할을 내려놓거나 회사를 떠나도 마지막 관리자는 남아야 합니다.
+func TestCompanyLastAdminConcurrent(t *testing.T) {
+	tests := []struct {
+		name  string
+		leave func(s *service.CompanyService, companyID, userID string) error
+	}{
+		{name: "update role", leave: func(s *service.CompanyService, companyID, userID string) error {
+			_, err := s.UpdateMemberRole(context.Background(), companyID, userID, userID, model.CompanyRoleMember)
+			return err
+		}},
+		{name: "remove member", leave: func(s *service.CompanyService, companyID, userID string) error {
+			return s.RemoveMember(context.Background(), companyID, userID, userID)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := memory.NewStore()
+			repos := memory.NewRepositories(store)
+			companies := &service.CompanyService{Repo: repos.Companies, UserRepo: repos.Users, UnitOfWork: &memory.MemoryUnitOfWork{Store: store}}
+
+			ctx := context.Background()
+			alice := &model.User{Name: "Alice", Email: "alice@acme.com", Language: "ko"}
+			bob := &model.User{Name: "Bob", Email: "bob@acme.com", Language: "en"}
+			for _, u := range []*model.User{alice, bob} {
+				if err := repos.Users.Create(ctx, u); err != nil {
+					t.Fatal(err)
+				}
+			}
+			company, err := companies.CreateCompany(ctx, alice.UserID, &model.CreateCompanyModel{Name: "Acme"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			invitation, err := companies.InviteMember(ctx, company.CompanyID, alice.UserID, &model.CompanyMemberModel{Email: bob.Email, Role: model.CompanyRoleAdmin})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := companies.AcceptInvitation(ctx, invitation.InvitationID, bob.UserID); err != nil {
+				t.Fatal(err)
+			}
+
+			start := make(chan struct{})
+			errs := make([]error, 2)
+			var wg sync.WaitGroup
+			for i, userID := range []string{alice.UserID, bob.UserID} {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					<-start
+					errs[i] = tt.leave(companies, company.CompanyID, userID)
+				}()
+			}
+			close(start)
+			wg.Wait()
+
+			var rejected int
+			for _, err := range errs {
+				switch {
+				case errors.Is(err, service.ErrLastCompanyAdmin):
+					rejected++
+				case err != nil:
+					t.Fatal(err)
+				}
+			}
+			if rejected != 1 {
+				t.Fatalf("errs = %v, 하나만 ErrLastCompanyAdmin 이어야 합니다", errs)
+			}
+			admins, err := repos.Companies.LockAdmins(ctx, company.CompanyID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(admins) != 1 {
+				t.Fatalf("admins = %v, want 1", admins)
+			}
+		})
+	}
+}
@@ -0,0 +1,486 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/B-Bridger/server/apperror"
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/repository"
+	"github.com/B-Bridger/server/tracing"
+	"github.com/B-Bridger/server/translation"
+)
+
+const (
+	maxGlossaryTermLength     = 255
+	maxGlossaryLanguageLength = 16
+	// CSV 한 번에 가져올 수 있는 최대 용어 수
+	maxGlossaryImportTerms = 5000
+)
+
+// 용어집 CSV 의 고정 열 이름, 나머지 열은 언어 코드로 취급합니다.
+const (
+	glossaryColumnSource         = "source"
+	glossaryColumnCaseSensitive  = "caseSensitive"
+	glossaryColumnDoNotTranslate = "doNotTranslate"
+)
+
+var (
+	ErrInvalidGlossaryScope = apperror.New(apperror.KindValidation, "invalid_glossary_scope", "용어집 범위가 올바르지 않습니다")
+	ErrGlossaryTermNotFound = repository.ErrGlossaryTermNotFound
+	ErrDuplicateGlossary    = apperror.New(apperror.KindConflict, "duplicate_glossary_term", "이미 등록된 용어입니다")
+	ErrInvalidGlossaryTerm  = apperror.New(apperror.KindValidation, "invalid_glossary_term", "용어 형식이 올바르지 않습니다")
+	ErrInvalidGlossaryCSV   = apperror.New(apperror.KindValidation, "invalid_glossary_csv", "용어집 CSV 형식이 올바르지 않습니다")
+)
+
+// GlossaryService는 회사, 채팅방 단위 용어집과 관련된 비즈니스 로직을 담당합니다.
+// 회사 용어집은 회사 소속 사용자가 조회하고 회사 관리자가 수정하며,
+// 채팅방 용어집은 채팅방 멤버가 조회하고 owner, admin 이 수정합니다.
+//
+// Methods:
+//   - GetTerms (용어 목록 조회)
+//   - CreateTerm, UpdateTerm, DeleteTerm (용어 관리)
+//   - ImportTerms (CSV 가져오기)
+type GlossaryService struct {
+	Repo       repository.GlossaryRepository
+	UserRepo   repository.UserRepository
+	MemberRepo repository.ChatRoomMemberRepository
+}
+
+// GetTerms는 용어집의 용어 목록을 반환합니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - scope: 적용 범위 (company, chatRoom)
+//   - scopeID: 회사 또는 채팅방의 고유 ID
+//   - actorID: 요청한 사용자의 고유 ID
+//
+// 반환 값
+//   - []GlossaryTerm: 불러온 용어 목록
+//   - error: 조회 권한이 없거나 실패 시 error 메세지
+func (s *GlossaryService) GetTerms(ctx context.Context, scope, scopeID, actorID string) (_ []model.GlossaryTerm, err error) {
+	ctx, span := tracing.Start(ctx, "GlossaryService.GetTerms")
+	defer func() { tracing.End(span, err) }()
+
+	if err := s.authorize(ctx, scope, scopeID, actorID, false); err != nil {
+		return nil, err
+	}
+	return s.Repo.FindTerms(ctx, scope, []string{scopeID})
+}
+
+// CreateTerm은 용어집에 새로운 용어를 추가합니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - scope: 적용 범위 (company, chatRoom)
+//   - scopeID: 회사 또는 채팅방의 고유 ID
+//   - actorID: 요청한 사용자의 고유 ID
+//   - req: 용어 정보
+//
+// 반환 값
+//   - *GlossaryTerm: 생성된 용어
+//   - error: 같은 원문이 이미 있으면 ErrDuplicateGlossary, 실패 시 error 메세지
+func (s *GlossaryService) CreateTerm(ctx context.Context, scope, scopeID, actorID string, req *model.GlossaryTermModel) (_ *model.GlossaryTerm, err error) {
+	ctx, span := tracing.Start(ctx, "GlossaryService.CreateTerm")
+	defer func() { tracing.End(span, err) }()
+
+	if err := s.authorize(ctx, scope, scopeID, actorID, true); err != nil {
+		return nil, err
+	}
+
+	term, err := newGlossaryTerm(scope, scopeID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.Repo.FindTerms(ctx, scope, []string{scopeID})
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range existing {
+		if e.Source == term.Source {
+			return nil, ErrDuplicateGlossary
+		}
+	}
+
+	if err := s.Repo.CreateTerm(ctx, term); err != nil {
+		return nil, err
+	}
+	return s.Repo.FindTermByID(ctx, term.TermID)
+}
+
+// UpdateTerm은 용어 정보를 수정합니다. 번역어 목록은 요청한 값으로 교체됩니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - scope: 적용 범위 (company, chatRoom)
+//   - scopeID: 회사 또는 채팅방의 고유 ID
+//   - actorID: 요청한 사용자의 고유 ID
+//   - termID: 용어의 고유 ID
+//   - req: 수정할 용어 정보
+//
+// 반환 값
+//   - *GlossaryTerm: 수정된 용어
+//   - error: 실패 시 error 메세지
+func (s *GlossaryService) UpdateTerm(ctx context.Context, scope, scopeID, actorID, termID string, req *model.GlossaryTermModel) (_ *model.GlossaryTerm, err error) {
+	ctx, span := tracing.Start(ctx, "GlossaryService.UpdateTerm")
+	defer func() { tracing.End(span, err) }()
+
+	if err := s.authorize(ctx, scope, scopeID, actorID, true); err != nil {
+		return nil, err
+	}
+	if _, err := s.findTerm(ctx, scope, scopeID, termID); err != nil {
+		return nil, err
+	}
+
+	term, err := newGlossaryTerm(scope, scopeID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.Repo.FindTerms(ctx, scope, []string{scopeID})
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range existing {
+		if e.Source == term.Source && e.TermID != termID {
+			return nil, ErrDuplicateGlossary
+		}
+	}
+
+	term.TermID = termID
+	if err := s.Repo.UpdateTerm(ctx, term); err != nil {
+		return nil, err
+	}
+	return s.Repo.FindTermByID(ctx, termID)
+}
+
+// DeleteTerm은 용어집에서 용어를 제거합니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - scope: 적용 범위 (company, chatRoom)
+//   - scopeID: 회사 또는 채팅방의 고유 ID
+//   - actorID: 요청한 사용자의 고유 ID
+//   - termID: 용어의 고유 ID
+//
+// 반환 값
+//   - error: 실패 시 error 메세지
+func (s *GlossaryService) DeleteTerm(ctx context.Context, scope, scopeID, actorID, termID string) (err error) {
+	ctx, span := tracing.Start(ctx, "GlossaryService.DeleteTerm")
+	defer func() { tracing.End(span, err) }()
+
+	if err := s.authorize(ctx, scope, scopeID, actorID, true); err != nil {
+		return err
+	}
+	if _, err := s.findTerm(ctx, scope, scopeID, termID); err != nil {
+		return err
+	}
+	return s.Repo.DeleteTerm(ctx, termID)
+}
+
+// ImportTerms는 CSV 로 용어를 가져옵니다.
+// 같은 원문의 용어가 이미 있으면 CSV 의 값으로 교체하며, 하나라도 형식이 잘못되면 아무것도 저장하지 않습니다.
+//
+// CSV 의 첫 행은 열 이름이어야 합니다.
+// source 열은 필수이고, caseSensitive, doNotTranslate 열은 선택이며, 나머지 열은 언어 코드(en, ja 등)입니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - scope: 적용 범위 (company, chatRoom)
+//   - scopeID: 회사 또는 채팅방의 고유 ID
+//   - actorID: 요청한 사용자의 고유 ID
+//   - r: CSV 데이터
+//
+// 반환 값
+//   - int: 저장된 용어 수
+//   - error: 형식이 잘못되었으면 ErrInvalidGlossaryCSV, 실패 시 error 메세지
+func (s *GlossaryService) ImportTerms(ctx context.Context, scope, scopeID, actorID string, r io.Reader) (_ int, err error) {
+	ctx, span := tracing.Start(ctx, "GlossaryService.ImportTerms")
+	defer func() { tracing.End(span, err) }()
+
+	if err := s.authorize(ctx, scope, scopeID, actorID, true); err != nil {
+		return 0, err
+	}
+
+	reqs, err := ReadGlossaryCSV(r)
+	if err != nil {
+		return 0, err
+	}
+
+	// 같은 원문이 여러 번 나오면 마지막 행을 사용합니다.
+	index := make(map[string]int, len(reqs))
+	var terms []model.GlossaryTerm
+	for i := range reqs {
+		term, err := newGlossaryTerm(scope, scopeID, &reqs[i])
+		if err != nil {
+			return 0, ErrInvalidGlossaryCSV.WithDetail("%d번째 용어: %v", i+1, err)
+		}
+		if at, ok := index[term.Source]; ok {
+			terms[at] = *term
+			continue
+		}
+		index[term.Source] = len(terms)
+		terms = append(terms, *term)
+	}
+
+	if err := s.Repo.UpsertTerms(ctx, terms); err != nil {
+		return 0, err
+	}
+	return len(terms), nil
+}
+
+// findTerm은 용어가 주어진 용어집에 속한 경우에만 반환합니다.
+func (s *GlossaryService) findTerm(ctx context.Context, scope, scopeID, termID string) (*model.GlossaryTerm, error) {
+	term, err := s.Repo.FindTermByID(ctx, termID)
+	if err != nil {
+		return nil, err
+	}
+	if term.Scope != scope || term.ScopeID != scopeID {
+		return nil, ErrGlossaryTermNotFound
+	}
+	return term, nil
+}
+
+// authorize는 용어집 조회 권한을, write 이면 수정 권한을 확인합니다.
+func (s *GlossaryService) authorize(ctx context.Context, scope, scopeID, actorID string, write bool) error {
+	switch scope {
+	case model.GlossaryScopeCompany:
+		actor, err := s.UserRepo.FindByID(ctx, actorID)
+		if err != nil {
+			return err
+		}
+		if actor.CompanyID == "" || actor.CompanyID != scopeID {
+			return ErrNotCompanyMember
+		}
+		if write && actor.CompanyRole != model.CompanyRoleAdmin {
+			return ErrNoPermission
+		}
+	case model.GlossaryScopeChatRoom:
+		member, err := s.MemberRepo.FindMember(ctx, scopeID, actorID)
+		if errors.Is(err, repository.ErrChatRoomMemberNotFound) {
+			return ErrNotChatRoomMember
+		}
+		if err != nil {
+			return err
+		}
+		if write && !member.CanManageMembers() {
+			return ErrNoPermission
+		}
+	default:
+		return ErrInvalidGlossaryScope
+	}
+	return nil
+}
+
+// newGlossaryTerm은 요청 값을 검증하고 GlossaryTerm 객체로 변환합니다.
+func newGlossaryTerm(scope, scopeID string, req *model.GlossaryTermModel) (*model.GlossaryTerm, error) {
+	source := strings.TrimSpace(req.Source)
+	if source == "" || len([]rune(source)) > maxGlossaryTermLength {
+		return nil, ErrInvalidGlossaryTerm.WithDetail("원문은 1자 이상 %d자 이하여야 합니다", maxGlossaryTermLength)
+	}
+
+	term := &model.GlossaryTerm{
+		Scope:          scope,
+		ScopeID:        scopeID,
+		Source:         source,
+		CaseSensitive:  req.CaseSensitive,
+		DoNotTranslate: req.DoNotTranslate,
+	}
+
+	for language, target := range req.Targets {
+		language = strings.TrimSpace(language)
+		target = strings.TrimSpace(target)
+		if target == "" {
+			continue
+		}
+		if language == "" || len(language) > maxGlossaryLanguageLength {
+			return nil, ErrInvalidGlossaryTerm.WithDetail("언어 코드가 올바르지 않습니다: %q", language)
+		}
+		if len([]rune(target)) > maxGlossaryTermLength {
+			return nil, ErrInvalidGlossaryTerm.WithDetail("번역어는 %d자 이하여야 합니다", maxGlossaryTermLength)
+		}
+		term.Targets = append(term.Targets, model.GlossaryTarget{Language: language, Target: target})
+	}
+	sort.Slice(term.Targets, func(i, j int) bool {
+		return term.Targets[i].Language < term.Targets[j].Language
+	})
+
+	if !term.DoNotTranslate && len(term.Targets) == 0 {
+		return nil, ErrInvalidGlossaryTerm.WithDetail("번역어가 하나 이상 필요합니다 (번역하지 않을 용어는 doNotTranslate 를 지정합니다)")
+	}
+
+	return term, nil
+}
+
+// ReadGlossaryCSV는 용어집 CSV 를 읽어 용어 목록으로 변환합니다.
+//
+// 매개 변수
+//   - r: CSV 데이터
+//
+// 반환 값
+//   - []GlossaryTermModel: 읽은 용어 목록
+//   - error: 형식이 잘못되었으면 ErrInvalidGlossaryCSV
+func ReadGlossaryCSV(r io.Reader) ([]model.GlossaryTermModel, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, ErrInvalidGlossaryCSV.WithDetail("열 이름 행을 읽을 수 없습니다: %v", err)
+	}
+
+	sourceColumn, caseColumn, keepColumn := -1, -1, -1
+	languages := make(map[int]string)
+	for i, name := range header {
+		name = strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))
+		switch {
+		case strings.EqualFold(name, glossaryColumnSource):
+			sourceColumn = i
+		case strings.EqualFold(name, glossaryColumnCaseSensitive):
+			caseColumn = i
+		case strings.EqualFold(name, glossaryColumnDoNotTranslate):
+			keepColumn = i
+		case name != "":
+			languages[i] = name
+		}
+	}
+	if sourceColumn < 0 {
+		return nil, ErrInvalidGlossaryCSV.WithDetail("%s 열이 없습니다", glossaryColumnSource)
+	}
+
+	var terms []model.GlossaryTermModel
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, ErrInvalidGlossaryCSV.WithDetail("%v", err)
+		}
+		if len(terms) >= maxGlossaryImportTerms {
+			return nil, ErrInvalidGlossaryCSV.WithDetail("한 번에 최대 %d개까지 가져올 수 있습니다", maxGlossaryImportTerms)
+		}
+
+		term := model.GlossaryTermModel{Source: record[sourceColumn], Targets: make(map[string]string)}
+		if term.CaseSensitive, err = parseCSVBool(record, caseColumn); err != nil {
+			return nil, ErrInvalidGlossaryCSV.WithDetail("%d행 %s: %v", line, glossaryColumnCaseSensitive, err)
+		}
+		if term.DoNotTranslate, err = parseCSVBool(record, keepColumn); err != nil {
+			return nil, ErrInvalidGlossaryCSV.WithDetail("%d행 %s: %v", line, glossaryColumnDoNotTranslate, err)
+		}
+		for i, language := range languages {
+			term.Targets[language] = record[i]
+		}
+		terms = append(terms, term)
+	}
+
+	return terms, nil
+}
+
+// WriteGlossaryCSV는 용어 목록을 ReadGlossaryCSV 로 다시 읽을 수 있는 CSV 로 기록합니다.
+//
+// 매개 변수
+//   - w: CSV 를 기록할 Writer
+//   - terms: 용어 목록
+//
+// 반환 값
+//   - error: 실패 시 error 메세지
+func WriteGlossaryCSV(w io.Writer, terms []model.GlossaryTerm) error {
+	seen := make(map[string]struct{})
+	var languages []string
+	for _, term := range terms {
+		for _, target := range term.Targets {
+			if _, ok := seen[target.Language]; ok {
+				continue
+			}
+			seen[target.Language] = struct{}{}
+			languages = append(languages, target.Language)
+		}
+	}
+	sort.Strings(languages)
+
+	writer := csv.NewWriter(w)
+	header := append([]string{glossaryColumnSource, glossaryColumnCaseSensitive, glossaryColumnDoNotTranslate}, languages...)
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, term := range terms {
+		record := []string{term.Source, strconv.FormatBool(term.CaseSensitive), strconv.FormatBool(term.DoNotTranslate)}
+		for _, language := range languages {
+			target := ""
+			for _, t := range term.Targets {
+				if t.Language == language {
+					target = t.Target
+					break
+				}
+			}
+			record = append(record, target)
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func parseCSVBool(record []string, column int) (bool, error) {
+	if column < 0 {
+		return false, nil
+	}
+	value := strings.TrimSpace(record[column])
+	if value == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(value)
+}
+
+// mergeGlossaries는 회사 용어집과 채팅방 용어집을 합칩니다.
+// 같은 원문의 용어가 있으면 채팅방 용어집이 우선합니다.
+func mergeGlossaries(companyTerms, chatRoomTerms []model.GlossaryTerm) []model.GlossaryTerm {
+	merged := make(map[string]model.GlossaryTerm, len(companyTerms)+len(chatRoomTerms))
+	for _, terms := range [][]model.GlossaryTerm{companyTerms, chatRoomTerms} {
+		for _, term := range terms {
+			merged[strings.ToLower(term.Source)] = term
+		}
+	}
+
+	result := make([]model.GlossaryTerm, 0, len(merged))
+	for _, term := range merged {
+		result = append(result, term)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Source < result[j].Source
+	})
+	return result
+}
+
+// glossaryEntries는 원문에 등장하는 용어 중 대상 언어의 번역어가 있는 용어만 골라
+// 번역 요청에 사용할 형태로 변환합니다.
+func glossaryEntries(terms []model.GlossaryTerm, text, language string) []translation.GlossaryEntry {
+	var entries []translation.GlossaryEntry
+	for i := range terms {
+		target, ok := terms[i].TargetFor(language)
+		if !ok {
+			continue
+		}
+		entry := translation.GlossaryEntry{
+			Source:         terms[i].Source,
+			Target:         target,
+			CaseSensitive:  terms[i].CaseSensitive,
+			DoNotTranslate: terms[i].DoNotTranslate,
+		}
+		if entry.AppearsIn(text) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
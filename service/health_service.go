@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/B-Bridger/server/model"
+)
+
+// 검사 하나에 허용되는 기본 시간
+const defaultHealthCheckTimeout = 2 * time.Second
+
+// HealthCheck는 readiness 검사 하나입니다.
+type HealthCheck struct {
+	Name string
+	// true 이면 실패해도 readiness 를 실패로 보지 않고 결과에만 포함합니다.
+	// 외부 API 처럼 모든 인스턴스가 함께 실패하는 의존성에 사용합니다.
+	Optional bool
+	Check    func(ctx context.Context) error
+}
+
+// HealthService는 서버가 요청을 처리할 수 있는 상태인지 확인합니다.
+//
+// Methods:
+//   - Ready (readiness 검사)
+//   - Drain (종료 중으로 전환)
+type HealthService struct {
+	Checks []HealthCheck
+	// 검사 하나에 허용되는 시간, 0 이면 defaultHealthCheckTimeout
+	Timeout time.Duration
+
+	draining atomic.Bool
+}
+
+// Drain은 서버를 종료 중 상태로 전환합니다. 이후 Ready 는 항상 실패합니다.
+func (s *HealthService) Drain() {
+	s.draining.Store(true)
+}
+
+// Ready는 모든 검사를 동시에 실행하고 결과를 반환합니다.
+//
+// 매개 변수
+//   - ctx: 요청 context
+//
+// 반환 값
+//   - bool: 종료 중이 아니고 Optional 이 아닌 검사가 모두 성공하면 true
+//   - []HealthCheckResult: Checks 와 같은 순서의 검사 결과
+//
+// /readyz 는 인증 없이 호출되므로, 결과에는 성공 여부만 담고 실패 원인은 로그로만 남깁니다.
+func (s *HealthService) Ready(ctx context.Context) (bool, []model.HealthCheckResult) {
+	timeout := s.Timeout
+	if timeout <= 0 {
+		timeout = defaultHealthCheckTimeout
+	}
+
+	results := make([]model.HealthCheckResult, len(s.Checks))
+	var wg sync.WaitGroup
+	for i, check := range s.Checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			start := time.Now()
+			err := check.Check(ctx)
+			results[i] = model.HealthCheckResult{
+				Name:      check.Name,
+				Status:    model.HealthStatusOK,
+				LatencyMs: time.Since(start).Milliseconds(),
+				Optional:  check.Optional,
+			}
+			if err != nil {
+				results[i].Status = model.HealthStatusFail
+				slog.WarnContext(ctx, "readiness 검사 실패", slog.String("check", check.Name), slog.Bool("optional", check.Optional), slog.Any("error", err))
+			}
+		}()
+	}
+	wg.Wait()
+
+	ready := true
+	for _, r := range results {
+		if r.Status != model.HealthStatusOK && !r.Optional {
+			ready = false
+		}
+	}
+	if s.draining.Load() {
+		ready = false
+		results = append(results, model.HealthCheckResult{Name: "shutdown", Status: model.HealthStatusFail})
+	}
+	return ready, results
+}
@@ -0,0 +1,372 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/B-Bridger/server/apperror"
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/repository"
+	"github.com/B-Bridger/server/tracing"
+	"github.com/B-Bridger/server/translation"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	defaultMessagePageSize = 50
+	maxMessagePageSize     = 100
+	// 번역 품질을 위해 함께 전달하는 이전 메세지 수
+	translationContextSize = 5
+	// 메세지 하나의 전체 번역에 허용되는 시간
+	translationTimeout = 30 * time.Second
+)
+
+var (
+	ErrEmptyMessage  = apperror.New(apperror.KindValidation, "empty_message", "메세지 내용이 비어있습니다")
+	ErrInvalidCursor = apperror.New(apperror.KindValidation, "invalid_cursor", "cursor가 올바르지 않습니다")
+)
+
+// MessageBroadcaster는 저장된 메세지를 채팅방 참여자에게 실시간으로 전달하는 역할을 추상화합니다.
+// 구현체는 참여자마다 각자의 Language 로 변환된 메세지를 전달해야 합니다.
+type MessageBroadcaster interface {
+	BroadcastMessage(chatRoomID string, message *model.Message)
+}
+
+// MessageNotifier는 저장된 메세지를 채팅방에 접속하지 않은 참여자에게 알리는 역할을 추상화합니다.
+// 구현체는 메세지 저장 요청을 지연시키지 않도록 비동기로 동작해야 하며,
+// 요청이 끝난 뒤에도 발송할 수 있도록 ctx 의 취소와 deadline 을 따르지 않아야 합니다.
+type MessageNotifier interface {
+	NotifyMessage(ctx context.Context, message *model.Message)
+}
+
+// MessageService는 채팅 메세지 도메인과 관련된 비즈니스 로직을 담당합니다.
+//
+// Methods:
+//   - GetMessages (메세지 목록 조회)
+//   - GetMessage (메세지 원문 조회)
+//   - PostMessage (메세지 전송 및 번역)
+type MessageService struct {
+	Repo         repository.MessageRepository
+	UserRepo     repository.UserRepository
+	ChatRoomRepo repository.ChatRoomRepository
+	MemberRepo   repository.ChatRoomMemberRepository
+	GlossaryRepo repository.GlossaryRepository
+	Translator   translation.Translator
+	Broadcaster  MessageBroadcaster
+	Notifier     MessageNotifier
+}
+
+// GetMessages는 채팅방의 메세지를 최신순으로 cursor 기반 페이지네이션하여 반환합니다.
+// 각 메세지는 조회하는 사용자의 Language 로 변환되며, 채팅방 멤버만 조회할 수 있습니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - chatRoomID: 채팅방의 고유 ID
+//   - userID: 조회하는 사용자의 고유 ID
+//   - cursor: 이전 페이지의 nextCursor 값 (첫 페이지는 빈 문자열)
+//   - limit: 페이지 크기 (0 이하이면 기본값, 최대 100)
+//   - withOriginal: true 이면 원문을 함께 반환합니다
+//
+// 반환 값
+//   - []MessageView: 불러온 메세지 목록
+//   - string: 다음 페이지 조회에 사용할 cursor (마지막 페이지이면 빈 문자열)
+//   - error: 멤버가 아니면 ErrNotChatRoomMember, 실패 시 error 메세지
+func (s *MessageService) GetMessages(ctx context.Context, chatRoomID, userID, cursor string, limit int, withOriginal bool) (_ []model.MessageView, _ string, err error) {
+	ctx, span := tracing.Start(ctx, "MessageService.GetMessages")
+	defer func() { tracing.End(span, err) }()
+
+	if limit <= 0 {
+		limit = defaultMessagePageSize
+	}
+	if limit > maxMessagePageSize {
+		limit = maxMessagePageSize
+	}
+
+	if err := s.authorizeMember(ctx, chatRoomID, userID); err != nil {
+		return nil, "", err
+	}
+
+	if cursor != "" {
+		last, err := s.Repo.FindByID(ctx, cursor)
+		if errors.Is(err, repository.ErrMessageNotFound) || (err == nil && last.ChatRoomID != chatRoomID) {
+			return nil, "", ErrInvalidCursor
+		}
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	reader, err := s.UserRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// 다음 페이지 존재 여부를 확인하기 위해 하나 더 조회합니다.
+	messages, err := s.Repo.FindByChatRoom(ctx, chatRoomID, cursor, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextCursor := ""
+	if len(messages) > limit {
+		messages = messages[:limit]
+		nextCursor = messages[limit-1].MessageID
+	}
+
+	views := make([]model.MessageView, 0, len(messages))
+	for i := range messages {
+		views = append(views, messages[i].Localize(reader.Language, withOriginal))
+	}
+
+	return views, nextCursor, nil
+}
+
+// GetMessage는 메세지 하나를 조회하는 사용자의 Language 로 변환하고 원문과 함께 반환합니다.
+// 채팅방 멤버만 조회할 수 있습니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - chatRoomID: 채팅방의 고유 ID
+//   - messageID: 메세지의 고유 ID
+//   - userID: 조회하는 사용자의 고유 ID
+//
+// 반환 값
+//   - *MessageView: 원문이 포함된 메세지
+//   - error: 멤버가 아니면 ErrNotChatRoomMember, 실패 시 error 메세지
+func (s *MessageService) GetMessage(ctx context.Context, chatRoomID, messageID, userID string) (_ *model.MessageView, err error) {
+	ctx, span := tracing.Start(ctx, "MessageService.GetMessage")
+	defer func() { tracing.End(span, err) }()
+
+	if err := s.authorizeMember(ctx, chatRoomID, userID); err != nil {
+		return nil, err
+	}
+
+	message, err := s.Repo.FindByID(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+	if message.ChatRoomID != chatRoomID {
+		return nil, repository.ErrMessageNotFound
+	}
+
+	reader, err := s.UserRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	view := message.Localize(reader.Language, true)
+	return &view, nil
+}
+
+// PostMessage는 채팅방에 새로운 메세지를 저장합니다.
+//
+// 채팅방 참여자들의 Language 중 원문 언어와 다른 언어마다 한 번씩만 번역하여
+// 원문과 함께 저장하고, 채팅방의 LastMessage, LastMessageAt 도 함께 갱신합니다.
+// 채팅방 용어집과 참여자 소속 회사의 용어집이 번역에 적용되며, 같은 원문은 채팅방 용어집이 우선합니다.
+// Broadcaster 가 설정되어 있으면 참여자마다 각자의 언어로 실시간 전달되고,
+// Notifier 가 설정되어 있으면 접속하지 않은 참여자에게 푸시 알림이 발송됩니다.
+// 원문 언어가 주어지지 않으면 보낸 사용자의 Language 를 사용합니다.
+// 채팅방 멤버만 메세지를 보낼 수 있습니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - chatRoomID: 채팅방의 고유 ID
+//   - userID: 메세지를 보낸 사용자의 고유 ID
+//   - req: 메세지 내용
+//
+// 반환 값
+//   - *MessageView: 보낸 사용자의 언어로 변환된 메세지
+//   - error: 멤버가 아니면 ErrNotChatRoomMember, 실패 시 error 메세지
+func (s *MessageService) PostMessage(ctx context.Context, chatRoomID, userID string, req *model.CreateMessageModel) (_ *model.MessageView, err error) {
+	ctx, span := tracing.Start(ctx, "MessageService.PostMessage")
+	defer func() { tracing.End(span, err) }()
+
+	if strings.TrimSpace(req.Content) == "" {
+		return nil, ErrEmptyMessage
+	}
+
+	if err := s.authorizeMember(ctx, chatRoomID, userID); err != nil {
+		return nil, err
+	}
+
+	sender, err := s.UserRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	message := model.Message{
+		ChatRoomID: chatRoomID,
+		UserID:     userID,
+		Content:    req.Content,
+		Language:   req.Language,
+	}
+	if message.Language == "" {
+		message.Language = sender.Language
+	}
+
+	if s.Translator != nil {
+		participants, err := s.ChatRoomRepo.FindParticipants(ctx, chatRoomID)
+		if err != nil {
+			return nil, err
+		}
+		glossary, err := s.glossary(ctx, chatRoomID, append(participants, *sender))
+		if err != nil {
+			return nil, err
+		}
+		message.Translations, err = s.translate(ctx, &message, targetLanguages(participants, sender, message.Language), glossary)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.Repo.Create(ctx, &message); err != nil {
+		return nil, err
+	}
+
+	created, err := s.Repo.FindByID(ctx, message.MessageID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, fanOut := tracing.Start(ctx, "MessageService.broadcast")
+	if s.Broadcaster != nil {
+		s.Broadcaster.BroadcastMessage(chatRoomID, created)
+	}
+	if s.Notifier != nil {
+		s.Notifier.NotifyMessage(ctx, created)
+	}
+	fanOut.End()
+
+	view := created.Localize(sender.Language, false)
+	return &view, nil
+}
+
+// targetLanguages는 참여자들의 Language 중 원문 언어를 제외한 서로 다른 언어 목록을 반환합니다.
+func targetLanguages(participants []model.User, sender *model.User, source string) []string {
+	seen := map[string]struct{}{source: {}}
+	var languages []string
+
+	// 메세지를 보낸 사용자가 아직 참여자로 집계되지 않았을 수 있으므로 함께 확인합니다.
+	users := append([]model.User{*sender}, participants...)
+	for _, u := range users {
+		if u.Language == "" {
+			continue
+		}
+		if _, ok := seen[u.Language]; ok {
+			continue
+		}
+		seen[u.Language] = struct{}{}
+		languages = append(languages, u.Language)
+	}
+
+	sort.Strings(languages)
+	return languages
+}
+
+// glossary는 채팅방과 참여자 소속 회사의 용어집을 합쳐 반환합니다.
+func (s *MessageService) glossary(ctx context.Context, chatRoomID string, participants []model.User) ([]model.GlossaryTerm, error) {
+	if s.GlossaryRepo == nil {
+		return nil, nil
+	}
+
+	companyTerms, err := s.GlossaryRepo.FindTerms(ctx, model.GlossaryScopeCompany, companyIDs(participants))
+	if err != nil {
+		return nil, err
+	}
+	chatRoomTerms, err := s.GlossaryRepo.FindTerms(ctx, model.GlossaryScopeChatRoom, []string{chatRoomID})
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeGlossaries(companyTerms, chatRoomTerms), nil
+}
+
+// translate는 메세지를 언어별로 동시에 번역합니다.
+// 번역에 실패한 언어(용어집을 지키지 못한 경우 포함)는 제외되며, 해당 언어 사용자는 원문을 받게 됩니다.
+// 번역 중에 요청이 취소되거나 요청의 deadline 이 지나면 진행 중인 번역을 중단하고 오류를 반환합니다.
+// (번역 자체의 제한 시간 translationTimeout 이 지난 경우는 그때까지 끝난 번역만 사용합니다)
+func (s *MessageService) translate(ctx context.Context, message *model.Message, languages []string, glossary []model.GlossaryTerm) (_ []model.MessageTranslation, err error) {
+	if len(languages) == 0 {
+		return nil, nil
+	}
+
+	ctx, span := tracing.Start(ctx, "MessageService.translate", trace.WithAttributes(attribute.StringSlice("translation.target_languages", languages)))
+	defer func() { tracing.End(span, err) }()
+
+	history := s.recentContext(ctx, message.ChatRoomID)
+
+	parent := ctx
+	ctx, cancel := context.WithTimeout(ctx, translationTimeout)
+	defer cancel()
+
+	var (
+		wg           sync.WaitGroup
+		mu           sync.Mutex
+		translations []model.MessageTranslation
+	)
+	for _, language := range languages {
+		wg.Add(1)
+		go func(language string) {
+			defer wg.Done()
+
+			result, err := s.Translator.Translate(ctx, &translation.Request{
+				Text:           message.Content,
+				SourceLanguage: message.Language,
+				TargetLanguage: language,
+				Context:        history,
+				Glossary:       glossaryEntries(glossary, message.Content, language),
+			})
+			if err != nil {
+				slog.WarnContext(ctx, "메세지 번역 실패", slog.String("sourceLanguage", message.Language), slog.String("targetLanguage", language), slog.Any("error", err))
+				return
+			}
+
+			mu.Lock()
+			translations = append(translations, model.MessageTranslation{Language: language, Content: result.Text, Provider: result.Provider})
+			mu.Unlock()
+		}(language)
+	}
+	wg.Wait()
+
+	if err := parent.Err(); err != nil {
+		return nil, apperror.Canceled(err)
+	}
+
+	sort.Slice(translations, func(i, j int) bool {
+		return translations[i].Language < translations[j].Language
+	})
+	return translations, nil
+}
+
+// recentContext는 번역 맥락으로 사용할 최근 메세지를 오래된 순으로 반환합니다.
+func (s *MessageService) recentContext(ctx context.Context, chatRoomID string) []string {
+	messages, err := s.Repo.FindByChatRoom(ctx, chatRoomID, "", translationContextSize)
+	if err != nil {
+		return nil
+	}
+
+	history := make([]string, 0, len(messages))
+	for i := len(messages) - 1; i >= 0; i-- {
+		history = append(history, messages[i].Sender.Name+": "+messages[i].Content)
+	}
+	return history
+}
+
+// authorizeMember는 채팅방이 존재하고 사용자가 채팅방 멤버인지 확인합니다.
+// 채팅방이 없으면 조회 error, 멤버가 아니면 ErrNotChatRoomMember 를 반환합니다.
+func (s *MessageService) authorizeMember(ctx context.Context, chatRoomID, userID string) error {
+	if _, err := s.ChatRoomRepo.FindByID(ctx, chatRoomID); err != nil {
+		return err
+	}
+	_, err := s.MemberRepo.FindMember(ctx, chatRoomID, userID)
+	if errors.Is(err, repository.ErrChatRoomMemberNotFound) {
+		return ErrNotChatRoomMember
+	}
+	return err
+}
@@ -0,0 +1,141 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/B-Bridger/server/apperror"
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/repository/memory"
+	"github.com/B-Bridger/server/service"
+	"github.com/B-Bridger/server/translation"
+)
+
+// blockingTranslator는 요청의 ctx 가 끝날 때까지 번역을 끝내지 않는 Translator 입니다.
+type blockingTranslator struct {
+	started chan struct{}
+}
+
+func (t *blockingTranslator) Name() string { return "blocking" }
+
+func (t *blockingTranslator) Translate(ctx context.Context, req *translation.Request) (*translation.Result, error) {
+	select {
+	case t.started <- struct{}{}:
+	default:
+	}
+	<-ctx.Done()
+	return nil, &translation.Error{Kind: translation.KindUnavailable, Provider: t.Name(), Err: ctx.Err()}
+}
+
+// countingBroadcaster는 전달한 메세지 수를 기록합니다.
+type countingBroadcaster struct {
+	count atomic.Int32
+}
+
+func (b *countingBroadcaster) BroadcastMessage(string, *model.Message) {
+	b.count.Add(1)
+}
+
+// 번역 중에 요청이 취소되면 메세지와 번역본을 저장하지 않고 전달하지도 않아야 합니다.
+func TestPostMessageCanceledWhileTranslating(t *testing.T) {
+	store := memory.NewStore()
+	repos := memory.NewRepositories(store)
+	translator := &blockingTranslator{started: make(chan struct{}, 1)}
+	broadcaster := &countingBroadcaster{}
+	chatRooms := &service.ChatRoomService{Repo: repos.ChatRooms, MemberRepo: repos.ChatRoomMembers, UserRepo: repos.Users, UnitOfWork: &memory.MemoryUnitOfWork{Store: store}}
+	messages := &service.MessageService{Repo: repos.Messages, UserRepo: repos.Users, ChatRoomRepo: repos.ChatRooms, MemberRepo: repos.ChatRoomMembers, GlossaryRepo: repos.Glossary, Translator: translator, Broadcaster: broadcaster}
+
+	ctx := context.Background()
+	alice := &model.User{Name: "Alice", Email: "alice@example.com", Language: "ko"}
+	bob := &model.User{Name: "Bob", Email: "bob@example.com", Language: "en"}
+	for _, u := range []*model.User{alice, bob} {
+		if err := repos.Users.Create(ctx, u); err != nil {
+			t.Fatal(err)
+		}
+	}
+	chatRoom, err := chatRooms.CreateChatRoom(ctx, alice.UserID, []string{bob.UserID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reqCtx, cancel := context.WithCancel(ctx)
+	go func() {
+		<-translator.started
+		cancel()
+	}()
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := messages.PostMessage(reqCtx, chatRoom.ChatRoomID, alice.UserID, &model.CreateMessageModel{Content: "안녕하세요"})
+		done <- err
+	}()
+
+	select {
+	case err = <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("요청이 취소된 뒤에도 번역을 기다렸습니다")
+	}
+	if !errors.Is(err, context.Canceled) || apperror.From(err).Kind != apperror.KindCanceled {
+		t.Fatalf("err = %v, want canceled", err)
+	}
+
+	views, _, err := messages.GetMessages(ctx, chatRoom.ChatRoomID, bob.UserID, "", 0, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(views) != 0 {
+		t.Fatalf("취소된 메세지가 저장되었습니다: %+v", views)
+	}
+	found, err := repos.ChatRooms.FindByID(ctx, chatRoom.ChatRoomID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found.LastMessage != "" {
+		t.Fatalf("lastMessage = %q", found.LastMessage)
+	}
+	if n := broadcaster.count.Load(); n != 0 {
+		t.Fatalf("취소된 메세지가 %d 번 전달되었습니다", n)
+	}
+}
+
+// 채팅방 멤버가 아니면 handler 를 거치지 않고 호출해도 메세지를 보내거나 조회할 수 없어야 합니다.
+func TestMessageServiceRequiresMembership(t *testing.T) {
+	store := memory.NewStore()
+	repos := memory.NewRepositories(store)
+	broadcaster := &countingBroadcaster{}
+	chatRooms := &service.ChatRoomService{Repo: repos.ChatRooms, MemberRepo: repos.ChatRoomMembers, UserRepo: repos.Users, UnitOfWork: &memory.MemoryUnitOfWork{Store: store}}
+	messages := &service.MessageService{Repo: repos.Messages, UserRepo: repos.Users, ChatRoomRepo: repos.ChatRooms, MemberRepo: repos.ChatRoomMembers, GlossaryRepo: repos.Glossary, Broadcaster: broadcaster}
+
+	ctx := context.Background()
+	alice := &model.User{Name: "Alice", Email: "alice@example.com", Language: "ko"}
+	carol := &model.User{Name: "Carol", Email: "carol@example.com", Language: "ja"}
+	for _, u := range []*model.User{alice, carol} {
+		if err := repos.Users.Create(ctx, u); err != nil {
+			t.Fatal(err)
+		}
+	}
+	chatRoom, err := chatRooms.CreateChatRoom(ctx, alice.UserID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sent, err := messages.PostMessage(ctx, chatRoom.ChatRoomID, alice.UserID, &model.CreateMessageModel{Content: "안녕하세요"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := messages.PostMessage(ctx, chatRoom.ChatRoomID, carol.UserID, &model.CreateMessageModel{Content: "こんにちは"}); !errors.Is(err, service.ErrNotChatRoomMember) {
+		t.Fatalf("PostMessage err = %v, want ErrNotChatRoomMember", err)
+	}
+	if _, _, err := messages.GetMessages(ctx, chatRoom.ChatRoomID, carol.UserID, "", 0, false); !errors.Is(err, service.ErrNotChatRoomMember) {
+		t.Fatalf("GetMessages err = %v, want ErrNotChatRoomMember", err)
+	}
+	if _, err := messages.GetMessage(ctx, chatRoom.ChatRoomID, sent.MessageID, carol.UserID); !errors.Is(err, service.ErrNotChatRoomMember) {
+		t.Fatalf("GetMessage err = %v, want ErrNotChatRoomMember", err)
+	}
+	if n := broadcaster.count.Load(); n != 1 {
+		t.Fatalf("메세지가 %d 번 전달되었습니다, want 1", n)
+	}
+}
@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/B-Bridger/server/model"
+	"github.com/B-Bridger/server/notification"
+	"github.com/B-Bridger/server/repository"
+)
+
+const (
+	// 메세지 하나의 전체 알림 발송에 허용되는 시간
+	notificationTimeout = 30 * time.Second
+	// 알림 본문의 최대 글자 수
+	maxNotificationBodyLength = 200
+	// 이 기간 동안 사용되지 않은 기기에는 알림을 보내지 않습니다.
+	deviceActiveWindow = 60 * 24 * time.Hour
+)
+
+// PresenceChecker는 사용자가 채팅방에 실시간으로 접속 중인지 확인하는 역할을 추상화합니다.
+type PresenceChecker interface {
+	IsConnected(chatRoomID, userID string) bool
+}
+
+// NotificationService는 새 메세지를 채팅방에 접속하지 않은 멤버에게 푸시 알림으로 전달합니다.
+//
+// 알림은 메세지 저장 요청과 분리되어 비동기로 발송되며, 본문은 수신자의 Language 로 번역된 내용입니다.
+// 수신자가 등록한 기기 중 최근에 사용된 모든 기기로 발송하며,
+// 보낸 사용자, 알림을 끈 멤버, WebSocket 으로 접속 중인 멤버는 제외됩니다.
+// 토큰이 잘못되었다는 응답을 받으면 해당 기기를 삭제합니다.
+//
+// Methods:
+//   - NotifyMessage (새 메세지 알림)
+//   - Wait (발송 중인 알림 대기)
+type NotificationService struct {
+	Notifier   notification.Notifier
+	MemberRepo repository.ChatRoomMemberRepository
+	DeviceRepo repository.DeviceRepository
+	Presence   PresenceChecker
+
+	wg sync.WaitGroup
+}
+
+// NotifyMessage는 새 메세지 알림을 비동기로 발송합니다.
+// 발송은 요청이 끝난 뒤에도 계속되므로 ctx 의 취소와 deadline 은 따르지 않고, trace 와 로그 속성만 이어갑니다.
+//
+// 매개 변수
+//   - ctx: 메세지를 저장한 요청의 context
+//   - message: 보낸 사용자(Sender)와 번역본(Translations)이 포함된 메세지
+func (s *NotificationService) NotifyMessage(ctx context.Context, message *model.Message) {
+	if s.Notifier == nil {
+		return
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), notificationTimeout)
+		defer cancel()
+
+		if err := s.notifyMessage(ctx, message); err != nil {
+			slog.ErrorContext(ctx, "메세지 알림 발송 실패", slog.String("messageID", message.MessageID), slog.Any("error", err))
+		}
+	}()
+}
+
+// Wait는 발송 중인 알림이 모두 끝날 때까지 기다립니다.
+// 서버 종료 시 새로운 메세지를 더 이상 받지 않는 상태에서 호출해야 합니다.
+//
+// 매개 변수
+//   - ctx: 대기 기한
+//
+// 반환 값
+//   - error: 기한 내에 끝나지 않으면 ctx.Err()
+func (s *NotificationService) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *NotificationService) notifyMessage(ctx context.Context, message *model.Message) error {
+	members, err := s.MemberRepo.FindMembers(ctx, message.ChatRoomID)
+	if err != nil {
+		return err
+	}
+
+	recipients := make(map[string]*model.User)
+	var userIDs []string
+	for i := range members {
+		m := &members[i]
+		if m.UserID == message.UserID || m.Muted {
+			continue
+		}
+		if s.Presence != nil && s.Presence.IsConnected(message.ChatRoomID, m.UserID) {
+			continue
+		}
+		recipients[m.UserID] = &m.User
+		userIDs = append(userIDs, m.UserID)
+	}
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	devices, err := s.DeviceRepo.FindActiveByUsers(ctx, userIDs, time.Now().Add(-deviceActiveWindow))
+	if err != nil {
+		return err
+	}
+
+	// 같은 언어의 수신자는 같은 본문을 사용합니다.
+	bodies := make(map[string]string)
+	notifications := make([]*notification.Notification, 0, len(devices))
+	for _, d := range devices {
+		language := recipients[d.UserID].Language
+		body, ok := bodies[language]
+		if !ok {
+			body = truncate(message.Localize(language, false).Content, maxNotificationBodyLength)
+			bodies[language] = body
+		}
+
+		notifications = append(notifications, &notification.Notification{
+			Token: d.PushToken,
+			Title: message.Sender.Name,
+			Body:  body,
+			Data: map[string]string{
+				"type":       model.ChatEventMessage,
+				"chatRoomID": message.ChatRoomID,
+				"messageID":  message.MessageID,
+			},
+		})
+	}
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	errs := s.Notifier.Send(ctx, notifications)
+	for i, err := range errs {
+		switch {
+		case err == nil:
+		case errors.Is(err, notification.ErrInvalidToken):
+			if err := s.DeviceRepo.DeleteByToken(ctx, notifications[i].Token); err != nil {
+				slog.ErrorContext(ctx, "기기 삭제 실패", slog.String("deviceID", devices[i].DeviceID), slog.Any("error", err))
+			}
+		default:
+			slog.WarnContext(ctx, "알림 발송 실패", slog.String("deviceID", devices[i].DeviceID), slog.Any("error", err))
+		}
+	}
+	return nil
+}
+
+// truncate는 문자열을 최대 max 글자로 자르고, 잘린 경우 말줄임표를 붙입니다.
+func truncate(text string, max int) string {
+	runes := []rune(text)
+	if len(runes) <= max {
+		return text
+	}
+	return string(runes[:max]) + "…"
+}
@@ -1,16 +1,66 @@
 package service
 
 import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
-	"os"
+	"log/slog"
+	"net/mail"
+	"regexp"
+	"strings"
 	"time"
 
+	"github.com/B-Bridger/server/apperror"
 	"github.com/B-Bridger/server/model"
 	"github.com/B-Bridger/server/repository"
+	"github.com/B-Bridger/server/tracing"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
 
+const (
+	accessTokenTTL  = 30 * time.Minute
+	refreshTokenTTL = 14 * 24 * time.Hour
+	// 이메일 확인 토큰의 유효 기간
+	emailChangeTTL = 24 * time.Hour
+	// 사용자 이름의 최대 길이 (글자 수)
+	maxUserNameLength = 50
+	// 비밀번호 길이 제한, bcrypt 는 72 바이트까지만 사용합니다.
+	minPasswordLength = 8
+	maxPasswordBytes  = 72
+	// 존재하지 않는 이메일로 로그인할 때도 비밀번호를 비교하여 응답 시간으로 가입 여부를 알 수 없도록 합니다.
+	dummyPasswordHash = "$2a$10$M3aNC2jyiHEHOX4BnHqs6.GX2ARuCL3tkYV1tU0LSFESmsmyEdWRm"
+)
+
+var (
+	// 이메일이 없는 경우와 비밀번호가 틀린 경우를 구분하지 않습니다.
+	ErrInvalidCredentials  = apperror.New(apperror.KindUnauthorized, "invalid_credentials", "이메일 또는 비밀번호가 올바르지 않습니다")
+	ErrInvalidRefreshToken = apperror.New(apperror.KindUnauthorized, "invalid_refresh_token", "refresh token 이 유효하지 않습니다")
+	ErrRefreshTokenReused  = apperror.New(apperror.KindUnauthorized, "refresh_token_reused", "이미 사용된 refresh token 입니다")
+	ErrInvalidDevice       = apperror.New(apperror.KindValidation, "invalid_device", "기기 정보가 올바르지 않습니다")
+	ErrDeviceNotFound      = apperror.New(apperror.KindNotFound, "device_not_found", "기기를 찾을 수 없습니다")
+	ErrInvalidUserName     = apperror.New(apperror.KindValidation, "invalid_user_name", "이름은 1자 이상 50자 이하여야 합니다")
+	ErrInvalidLanguage     = apperror.New(apperror.KindValidation, "invalid_language", "언어 코드가 올바르지 않습니다")
+	ErrInvalidEmail        = apperror.New(apperror.KindValidation, "invalid_email", "이메일 형식이 올바르지 않습니다")
+	ErrSameEmail           = apperror.New(apperror.KindValidation, "same_email", "현재 사용 중인 이메일입니다")
+	ErrInvalidPassword     = apperror.New(apperror.KindValidation, "invalid_password", "비밀번호는 8자 이상 72바이트 이하여야 합니다")
+	ErrIncorrectPassword   = apperror.New(apperror.KindForbidden, "incorrect_password", "현재 비밀번호가 올바르지 않습니다")
+	ErrInvalidEmailToken   = apperror.New(apperror.KindValidation, "invalid_email_token", "이메일 확인 토큰이 유효하지 않거나 만료되었습니다")
+)
+
+// 언어 코드 형식 (예: ko, en-US, zh_Hant)
+var languagePattern = regexp.MustCompile(`^[A-Za-z]{2,3}([-_][A-Za-z0-9]{2,8})*$`)
+
+// EmailSender는 이메일 확인 메일을 발송하는 역할을 추상화합니다.
+type EmailSender interface {
+	// SendEmailVerification은 새 이메일 주소로 확인 토큰을 발송합니다.
+	SendEmailVerification(ctx context.Context, email, token string) error
+}
+
 // UserService는 사용자 도메인과 관련된 비즈니스 로직을 담당합니다.
 // 이 서비스는 UserRepository 인터페이스에 의존하여 DB 구현과 분리된 구조를 가집니다.
 //
@@ -18,107 +68,694 @@ import (
 //   - GetUser (사용자 조회)
 //   - CreateUser (사용자 생성)
 //   - UpdateUser (사용자 정보 수정)
+//   - RequestEmailChange, ConfirmEmailChange (이메일 변경 및 확인)
+//   - ChangePassword (비밀번호 변경)
 //   - DeleteUser (사용자 삭제)
 //   - Authenticate (로그인 인증)
+//   - RefreshToken (토큰 재발급)
+//   - Logout (토큰 폐기)
+//   - RegisterDevice, GetDevices, RemoveDevice, RemoveSessionDevices (푸시 알림 기기 관리)
 type UserService struct {
-	Repo repository.UserRepository
+	Repo       repository.UserRepository
+	TokenRepo  repository.TokenRepository
+	DeviceRepo repository.DeviceRepository
+	// 여러 저장소에 걸친 변경(사용자 삭제 등)을 하나의 트랜잭션으로 실행합니다.
+	UnitOfWork repository.UnitOfWork
+	// 이메일 확인 메일 발송기, nil 이면 확인 토큰을 로그에 기록합니다. (개발 환경용)
+	EmailSender EmailSender
+	// access token 서명에 사용하는 비밀 키 (config.Auth.Secret)
+	JWTSecret string
 }
 
 // UserID를 통해 user 객체를 반환합니다.
 //
 // 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
 //   - id: 사용자의 고유 ID
 //
 // 반환 값
 //   - *User: 불러온 user 객체
 //   - error: 실패 시 error 메세지
-func (s *UserService) GetUser(id string) (*model.User, error) {
-	return s.Repo.FindByID(id)
+func (s *UserService) GetUser(ctx context.Context, id string) (_ *model.User, err error) {
+	ctx, span := tracing.Start(ctx, "UserService.GetUser")
+	defer func() { tracing.End(span, err) }()
+
+	return s.Repo.FindByID(ctx, id)
 }
 
 // 사용자를 생성합니다.
 // 비밀번호는 bcrypt를 통해 암호화 합니다.
 //
 // 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
 //   - user: user 객체 포인터
 //
 // 반환 값
 //   - error: 실패 시 error 메세지
-func (s *UserService) CreateUser(user *model.User) error {
+func (s *UserService) CreateUser(ctx context.Context, user *model.User) (err error) {
+	ctx, span := tracing.Start(ctx, "UserService.CreateUser")
+	defer func() { tracing.End(span, err) }()
+
 	hashed, err := bcrypt.GenerateFromPassword([]byte(user.Password), bcrypt.DefaultCost)
 	if err != nil {
 		return err
 	}
 	user.Password = string(hashed)
-	return s.Repo.Create(user)
+	return s.Repo.Create(ctx, user)
 }
 
-// 기존에 존재하는 사용자 정보를 수정합니다.
+// UpdateUser는 요청에 포함된 필드만 사용자 정보에 반영합니다. (JSON merge patch)
+// 이름은 지울 수 없고, 언어와 프로필 이미지는 null 로 지울 수 있습니다.
+// 이메일, 비밀번호, 회사 소속 등 나머지 필드는 저장된 값을 그대로 유지합니다.
 //
 // 매개 변수
-//   - user: user 객체 포인터
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - userID: 사용자의 고유 ID
+//   - req: 변경할 필드
 //
 // 반환 값
 //   - *User: 수정된 user 객체
-//   - error: 실패 시 error 메세지
-func (s *UserService) UpdateUser(user *model.User) (*model.User, error) {
-	return s.Repo.Update(user)
+//   - error: 값이 올바르지 않거나 실패 시 error 메세지
+func (s *UserService) UpdateUser(ctx context.Context, userID string, req *model.UpdateUserModel) (_ *model.User, err error) {
+	ctx, span := tracing.Start(ctx, "UserService.UpdateUser")
+	defer func() { tracing.End(span, err) }()
+
+	var updated *model.User
+	err = s.UnitOfWork.Do(ctx, func(ctx context.Context, repos *repository.Repositories) error {
+		user, err := repos.Users.FindByID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if err := applyUserPatch(user, req); err != nil {
+			return err
+		}
+		// 수정할 수 있는 열만 저장하므로, 그 사이 변경된 이메일이나 비밀번호를 덮어쓰지 않습니다.
+		if err := repos.Users.UpdateProfile(ctx, user); err != nil {
+			return err
+		}
+		updated = user
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// applyUserPatch는 요청 값을 검증하여 user 에 반영합니다.
+func applyUserPatch(user *model.User, req *model.UpdateUserModel) error {
+	if req.Name.Set {
+		name := strings.TrimSpace(req.Name.Value)
+		if req.Name.Null || name == "" || len([]rune(name)) > maxUserNameLength {
+			return ErrInvalidUserName
+		}
+		user.Name = name
+	}
+	if req.Language.Set {
+		language := strings.TrimSpace(req.Language.Value)
+		if !req.Language.Null && !languagePattern.MatchString(language) {
+			return ErrInvalidLanguage.WithDetail("%q", req.Language.Value)
+		}
+		user.Language = language
+	}
+	if req.Profile.Set {
+		if !req.Profile.Null {
+			return apperror.ErrReadOnlyField.WithDetail("profile: 프로필 이미지는 POST /users/profile-image 로 업로드합니다")
+		}
+		user.Profile = ""
+	}
+	return nil
 }
 
-// 사용자 레코드를 삭제합니다.
+// RequestEmailChange는 새 이메일로 확인 토큰을 발송합니다.
+// 사용자의 이메일은 ConfirmEmailChange 로 토큰을 확인한 뒤에 바뀌며, 이전에 발송한 토큰은 더 이상 사용할 수 없습니다.
 //
 // 매개 변수
-//   - id: 사용자의 고유 ID
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - userID: 사용자의 고유 ID
+//   - req: 새 이메일과 현재 비밀번호
 //
 // 반환 값
-//   - error: 실패 시 error 메세지
-func (s *UserService) DeleteUser(id string) error {
-	return s.Repo.Delete(id)
+//   - error: 비밀번호가 틀리면 ErrIncorrectPassword, 이미 사용 중인 이메일이면 ErrEmailTaken, 실패 시 error 메세지
+func (s *UserService) RequestEmailChange(ctx context.Context, userID string, req *model.ChangeEmailModel) (err error) {
+	ctx, span := tracing.Start(ctx, "UserService.RequestEmailChange")
+	defer func() { tracing.End(span, err) }()
+
+	email := strings.TrimSpace(req.Email)
+	if address, err := mail.ParseAddress(email); err != nil || address.Address != email {
+		return ErrInvalidEmail
+	}
+
+	user, err := s.Repo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
+		return ErrIncorrectPassword
+	}
+	if strings.EqualFold(email, user.Email) {
+		return ErrSameEmail
+	}
+	if _, err := s.Repo.FindByEmail(ctx, email); err == nil {
+		return repository.ErrEmailTaken
+	} else if !errors.Is(err, repository.ErrUserNotFound) {
+		return err
+	}
+
+	token, err := newSecretToken()
+	if err != nil {
+		return err
+	}
+	if err := s.Repo.CreateEmailChange(ctx, &model.EmailChange{
+		UserID:    userID,
+		Email:     email,
+		TokenHash: hashToken(token),
+		ExpiresAt: time.Now().Add(emailChangeTTL),
+	}); err != nil {
+		return err
+	}
+
+	if s.EmailSender == nil {
+		// 토큰 원문은 운영 환경의 로그에 남지 않도록 debug 수준에서만 기록합니다.
+		slog.WarnContext(ctx, "이메일 발송기가 설정되지 않아 확인 메일을 발송하지 않았습니다", slog.String("email", email))
+		slog.DebugContext(ctx, "이메일 확인 토큰", slog.String("email", email), slog.String("verificationToken", token))
+		return nil
+	}
+	return s.EmailSender.SendEmailVerification(ctx, email, token)
+}
+
+// ConfirmEmailChange는 확인 토큰을 검증하고 사용자 이메일을 요청한 이메일로 변경합니다.
+// 토큰은 한 번만 사용할 수 있습니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - token: 새 이메일로 발송된 확인 토큰 원문
+//
+// 반환 값
+//   - *User: 수정된 user 객체
+//   - error: 토큰이 없거나 만료되었으면 ErrInvalidEmailToken, 그 사이 다른 사용자가 이메일을 사용하면 ErrEmailTaken
+func (s *UserService) ConfirmEmailChange(ctx context.Context, token string) (_ *model.User, err error) {
+	ctx, span := tracing.Start(ctx, "UserService.ConfirmEmailChange")
+	defer func() { tracing.End(span, err) }()
+
+	var updated *model.User
+	err = s.UnitOfWork.Do(ctx, func(ctx context.Context, repos *repository.Repositories) error {
+		change, err := repos.Users.FindEmailChangeByHash(ctx, hashToken(token))
+		if errors.Is(err, repository.ErrEmailChangeNotFound) {
+			return ErrInvalidEmailToken
+		}
+		if err != nil {
+			return err
+		}
+		if time.Now().After(change.ExpiresAt) {
+			return ErrInvalidEmailToken
+		}
+
+		user, err := repos.Users.FindByID(ctx, change.UserID)
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return ErrInvalidEmailToken
+		}
+		if err != nil {
+			return err
+		}
+		if err := repos.Users.UpdateEmail(ctx, user.UserID, change.Email); err != nil {
+			return err
+		}
+		user.Email = change.Email
+		updated = user
+		return repos.Users.DeleteEmailChanges(ctx, user.UserID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// ChangePassword는 현재 비밀번호를 확인한 뒤 비밀번호를 변경합니다.
+// 현재 로그인을 제외한 다른 로그인의 refresh token 은 모두 폐기되고 등록된 기기도 삭제됩니다.
+// (이미 발급된 access token 은 만료될 때까지 사용할 수 있습니다)
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - claims: 현재 요청의 access token claim
+//   - req: 현재 비밀번호와 새 비밀번호
+//
+// 반환 값
+//   - error: 현재 비밀번호가 틀리면 ErrIncorrectPassword, 새 비밀번호가 올바르지 않으면 ErrInvalidPassword
+func (s *UserService) ChangePassword(ctx context.Context, claims *model.BridgerClaims, req *model.ChangePasswordModel) (err error) {
+	ctx, span := tracing.Start(ctx, "UserService.ChangePassword")
+	defer func() { tracing.End(span, err) }()
+
+	if len([]rune(req.NewPassword)) < minPasswordLength || len(req.NewPassword) > maxPasswordBytes {
+		return ErrInvalidPassword
+	}
+
+	user, err := s.Repo.FindByID(ctx, claims.UserID)
+	if err != nil {
+		return err
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.CurrentPassword)); err != nil {
+		return ErrIncorrectPassword
+	}
+	hashed, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	return s.UnitOfWork.Do(ctx, func(ctx context.Context, repos *repository.Repositories) error {
+		if err := repos.Users.UpdatePassword(ctx, claims.UserID, string(hashed)); err != nil {
+			return err
+		}
+
+		familyIDs, err := repos.Tokens.RevokeOtherFamilies(ctx, claims.UserID, claims.SessionID)
+		if err != nil {
+			return err
+		}
+		for _, familyID := range familyIDs {
+			if err := repos.Devices.DeleteBySession(ctx, familyID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteUser는 현재 사용자를 탈퇴 처리합니다. 아래 작업은 모두 하나의 트랜잭션으로 처리됩니다.
+//   - 모든 로그인의 refresh token 과 현재 access token 을 폐기합니다.
+//   - 참여 중인 채팅방에서 나갑니다. 소유한 채팅방은 가장 먼저 참여한 관리자(없으면 멤버)에게 넘기고,
+//     남은 멤버가 없으면 채팅방을 메세지와 함께 삭제합니다.
+//   - 회사 소속을 해제합니다. 다른 사용자가 남은 회사의 마지막 관리자는 탈퇴할 수 없습니다.
+//   - 등록된 기기, 이메일 변경 요청, 사용자 레코드를 삭제합니다.
+//
+// 다른 멤버의 대화 기록이 유지되도록 보낸 메세지는 삭제하지 않으며,
+// 이후 메세지의 sender 에는 userID 만 남고 이름과 프로필은 비어 있습니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - claims: 현재 access token 의 claims
+//
+// 반환 값
+//   - error: 회사의 마지막 관리자이면 ErrLastCompanyAdmin, 실패 시 error 메세지
+func (s *UserService) DeleteUser(ctx context.Context, claims *model.BridgerClaims) (err error) {
+	ctx, span := tracing.Start(ctx, "UserService.DeleteUser")
+	defer func() { tracing.End(span, err) }()
+
+	id := claims.UserID
+	return s.UnitOfWork.Do(ctx, func(ctx context.Context, repos *repository.Repositories) error {
+		user, err := repos.Users.FindByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if user.CompanyID != "" {
+			if err := leaveCompany(ctx, repos, user); err != nil {
+				return err
+			}
+		}
+		if err := leaveChatRooms(ctx, repos, id); err != nil {
+			return err
+		}
+
+		if _, err := repos.Tokens.RevokeOtherFamilies(ctx, id, ""); err != nil {
+			return err
+		}
+		if claims.ID != "" && claims.ExpiresAt != nil {
+			if err := repos.Tokens.RevokeAccessToken(ctx, claims.ID, claims.ExpiresAt.Time); err != nil {
+				return err
+			}
+		}
+		if err := repos.Devices.DeleteByUser(ctx, id); err != nil {
+			return err
+		}
+		if err := repos.Users.DeleteEmailChanges(ctx, id); err != nil {
+			return err
+		}
+		return repos.Users.Delete(ctx, id)
+	})
+}
+
+// leaveCompany는 탈퇴하는 사용자의 회사 소속을 해제합니다.
+// 다른 사용자가 남아 있는 회사의 마지막 관리자이면 ErrLastCompanyAdmin 을 반환합니다.
+func leaveCompany(ctx context.Context, repos *repository.Repositories, user *model.User) error {
+	users, err := repos.Companies.FindUsers(ctx, user.CompanyID, "")
+	if err != nil {
+		return err
+	}
+	if len(users) > 1 {
+		if err := checkLastAdmin(ctx, repos.Companies, user.CompanyID, user.UserID); err != nil {
+			return err
+		}
+	}
+	return repos.Companies.SetMembership(ctx, user.UserID, "", "")
+}
+
+// leaveChatRooms는 탈퇴하는 사용자를 참여 중인 모든 채팅방에서 내보냅니다.
+// 소유한 채팅방은 다른 멤버에게 넘기고, 남은 멤버가 없으면 삭제합니다.
+func leaveChatRooms(ctx context.Context, repos *repository.Repositories, userID string) error {
+	chatRooms, err := repos.ChatRooms.FindByMember(ctx, userID)
+	if err != nil {
+		return err
+	}
+	owned, err := repos.ChatRooms.FindByOwner(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{})
+	for _, chatRoom := range append(*chatRooms, *owned...) {
+		if _, ok := seen[chatRoom.ChatRoomID]; ok {
+			continue
+		}
+		seen[chatRoom.ChatRoomID] = struct{}{}
+
+		if chatRoom.UserID == userID {
+			members, err := repos.ChatRoomMembers.FindMembers(ctx, chatRoom.ChatRoomID)
+			if err != nil {
+				return err
+			}
+			successor := nextChatRoomOwner(members, userID)
+			if successor == "" {
+				if err := repos.ChatRooms.Delete(ctx, chatRoom.ChatRoomID); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := repos.ChatRooms.TransferOwnership(ctx, chatRoom.ChatRoomID, successor); err != nil {
+				return err
+			}
+		}
+		if err := repos.ChatRoomMembers.RemoveMembers(ctx, chatRoom.ChatRoomID, []string{userID}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nextChatRoomOwner는 참여 순으로 정렬된 members 중 userID 를 제외하고
+// 가장 먼저 참여한 관리자, 관리자가 없으면 가장 먼저 참여한 멤버를 반환합니다. 남은 멤버가 없으면 빈 문자열입니다.
+func nextChatRoomOwner(members []model.ChatRoomMember, userID string) string {
+	successor := ""
+	for _, m := range members {
+		if m.UserID == userID {
+			continue
+		}
+		if m.Role == model.ChatRoomRoleAdmin {
+			return m.UserID
+		}
+		if successor == "" {
+			successor = m.UserID
+		}
+	}
+	return successor
 }
 
 // Authenticate는 주어진 이메일과 비밀번호를 검증하여 로그인 인증을 수행합니다.
-// 비밀번호는 bcrypt로 비교되며, 인증에 성공하면 사용자 정보와 토큰을 반환합니다.
+// 비밀번호는 bcrypt로 비교되며, 인증에 성공하면 사용자 정보와
+// access token, 새로운 family 의 refresh token 을 반환합니다.
 //
 // 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
 //   - email: 사용자의 이메일 주소
 //   - password: 사용자의 비밀번호 (평문)
 //
 // 반환 값
 //   - *User: 인증된 사용자 정보
-//   - string: 인증 성공 시 발급되는 토큰 문자열
-//   - error: 인증 실패 시 오류 메시지 반환
-func (s *UserService) Authenticate(email, password string) (*model.User, string, error) {
-	user, err := s.Repo.FindByEmail(email)
+//   - *TokenPair: 인증 성공 시 발급되는 토큰
+//   - error: 이메일이 없거나 비밀번호가 틀리면 ErrInvalidCredentials, 실패 시 error 메세지
+func (s *UserService) Authenticate(ctx context.Context, email, password string) (_ *model.User, _ *model.TokenPair, err error) {
+	ctx, span := tracing.Start(ctx, "UserService.Authenticate")
+	defer func() { tracing.End(span, err) }()
+
+	user, err := s.Repo.FindByEmail(ctx, email)
+	if errors.Is(err, repository.ErrUserNotFound) {
+		_ = bcrypt.CompareHashAndPassword([]byte(dummyPasswordHash), []byte(password))
+		return nil, nil, ErrInvalidCredentials
+	}
 	if err != nil {
-		return nil, "", errors.New("사용자를 찾을 수 없습니다")
+		return nil, nil, err
 	}
 
 	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)); err != nil {
-		return nil, "", errors.New("비밀번호가 일치하지 않습니다")
+		return nil, nil, ErrInvalidCredentials
+	}
+
+	tokens, err := s.issueTokens(ctx, user, uuid.NewString())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return user, tokens, nil
+}
+
+// RefreshToken은 refresh token 을 검증하고 새로운 토큰 쌍을 발급합니다.
+// 사용한 refresh token 은 폐기되며(rotation), 이미 폐기된 토큰이 다시 사용되면
+// 탈취된 것으로 보고 같은 family 의 토큰을 모두 폐기합니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - refreshToken: 클라이언트가 보관 중인 refresh token 원문
+//
+// 반환 값
+//   - *TokenPair: 새로 발급된 토큰
+//   - error: 실패 시 ErrInvalidRefreshToken 또는 ErrRefreshTokenReused
+func (s *UserService) RefreshToken(ctx context.Context, refreshToken string) (_ *model.TokenPair, err error) {
+	ctx, span := tracing.Start(ctx, "UserService.RefreshToken")
+	defer func() { tracing.End(span, err) }()
+
+	stored, err := s.TokenRepo.FindRefreshTokenByHash(ctx, hashToken(refreshToken))
+	if errors.Is(err, repository.ErrRefreshTokenNotFound) {
+		return nil, ErrInvalidRefreshToken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if stored.RevokedAt != nil {
+		if err := s.revokeSession(ctx, stored.FamilyID); err != nil {
+			return nil, err
+		}
+		return nil, ErrRefreshTokenReused
+	}
+	if time.Now().After(stored.ExpiresAt) {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	revoked, err := s.TokenRepo.RevokeRefreshToken(ctx, stored.TokenID)
+	if err != nil {
+		return nil, err
+	}
+	if !revoked {
+		// 동시에 같은 토큰으로 재발급을 요청한 경우
+		if err := s.revokeSession(ctx, stored.FamilyID); err != nil {
+			return nil, err
+		}
+		return nil, ErrRefreshTokenReused
+	}
+
+	// 회사 소속 등 변경된 사용자 정보를 새 토큰에 반영합니다.
+	user, err := s.Repo.FindByID(ctx, stored.UserID)
+	if errors.Is(err, repository.ErrUserNotFound) {
+		return nil, ErrInvalidRefreshToken
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	jwtSecret := os.Getenv("SECRET")
-	if jwtSecret == "" {
-		return nil, "", errors.New("JWT 비밀 키가 설정되지 않았습니다")
+	return s.issueTokens(ctx, user, stored.FamilyID)
+}
+
+// Logout은 현재 access token 을 denylist 에 추가하고 같은 로그인(session)의 refresh token 을 모두 폐기합니다.
+// 해당 로그인에서 등록한 기기도 함께 삭제되어 더 이상 푸시 알림을 받지 않습니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - claims: 현재 요청의 access token claim
+//
+// 반환 값
+//   - error: 실패 시 error 메세지
+func (s *UserService) Logout(ctx context.Context, claims *model.BridgerClaims) (err error) {
+	ctx, span := tracing.Start(ctx, "UserService.Logout")
+	defer func() { tracing.End(span, err) }()
+
+	if claims.ID != "" && claims.ExpiresAt != nil {
+		if err := s.TokenRepo.RevokeAccessToken(ctx, claims.ID, claims.ExpiresAt.Time); err != nil {
+			return err
+		}
+	}
+	if claims.SessionID != "" {
+		return s.revokeSession(ctx, claims.SessionID)
+	}
+	return nil
+}
+
+// revokeSession은 로그인(session)의 refresh token 을 모두 폐기하고 등록된 기기를 삭제합니다.
+func (s *UserService) revokeSession(ctx context.Context, sessionID string) error {
+	if err := s.TokenRepo.RevokeFamily(ctx, sessionID); err != nil {
+		return err
+	}
+	return s.DeviceRepo.DeleteBySession(ctx, sessionID)
+}
+
+// RegisterDevice는 현재 로그인(session)에 푸시 알림을 받을 기기를 등록합니다.
+// 앱은 실행될 때마다 호출하여 마지막 사용 시각과 앱 버전, 언어를 갱신합니다.
+// 같은 푸시 토큰이 이미 등록되어 있으면 현재 사용자와 로그인으로 옮깁니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - userID: 사용자의 고유 ID
+//   - sessionID: 현재 access token 의 sid
+//   - req: 기기 정보
+//
+// 반환 값
+//   - *Device: 등록된 기기
+//   - error: 기기 정보가 잘못되었으면 ErrInvalidDevice, 실패 시 error 메세지
+func (s *UserService) RegisterDevice(ctx context.Context, userID, sessionID string, req *model.RegisterDeviceModel) (_ *model.Device, err error) {
+	ctx, span := tracing.Start(ctx, "UserService.RegisterDevice")
+	defer func() { tracing.End(span, err) }()
+
+	token := strings.TrimSpace(req.PushToken)
+	if token == "" || len(token) > 255 {
+		return nil, ErrInvalidDevice.WithDetail("pushToken 이 비어있거나 너무 깁니다")
+	}
+	switch req.Platform {
+	case model.DevicePlatformAndroid, model.DevicePlatformIOS, model.DevicePlatformWeb:
+	default:
+		return nil, ErrInvalidDevice.WithDetail("지원하지 않는 platform 입니다: %q", req.Platform)
+	}
+	if len(req.AppVersion) > 32 || len(req.Locale) > 16 {
+		return nil, ErrInvalidDevice.WithDetail("appVersion 또는 locale 이 너무 깁니다")
 	}
 
+	device := model.Device{
+		UserID:     userID,
+		SessionID:  sessionID,
+		Platform:   req.Platform,
+		PushToken:  token,
+		AppVersion: req.AppVersion,
+		Locale:     req.Locale,
+		LastSeenAt: time.Now(),
+	}
+	if err := s.DeviceRepo.Upsert(ctx, &device); err != nil {
+		return nil, err
+	}
+
+	return &device, nil
+}
+
+// GetDevices는 사용자가 등록한 기기 목록을 반환합니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - userID: 사용자의 고유 ID
+//
+// 반환 값
+//   - []Device: 불러온 기기 목록
+//   - error: 실패 시 error 메세지
+func (s *UserService) GetDevices(ctx context.Context, userID string) (_ []model.Device, err error) {
+	ctx, span := tracing.Start(ctx, "UserService.GetDevices")
+	defer func() { tracing.End(span, err) }()
+
+	return s.DeviceRepo.FindByUser(ctx, userID)
+}
+
+// RemoveDevice는 사용자가 등록한 기기를 삭제합니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - userID: 사용자의 고유 ID
+//   - deviceID: 기기의 고유 ID
+//
+// 반환 값
+//   - error: 사용자의 기기가 아니면 ErrDeviceNotFound, 실패 시 error 메세지
+func (s *UserService) RemoveDevice(ctx context.Context, userID, deviceID string) (err error) {
+	ctx, span := tracing.Start(ctx, "UserService.RemoveDevice")
+	defer func() { tracing.End(span, err) }()
+
+	deleted, err := s.DeviceRepo.Delete(ctx, userID, deviceID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrDeviceNotFound
+	}
+	return nil
+}
+
+// RemoveSessionDevices는 현재 로그인(session)에서 등록한 기기를 삭제합니다.
+// 로그인은 유지한 채 이 기기에서만 푸시 알림을 끄는 경우 사용합니다.
+//
+// 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
+//   - sessionID: 현재 access token 의 sid
+//
+// 반환 값
+//   - error: 실패 시 error 메세지
+func (s *UserService) RemoveSessionDevices(ctx context.Context, sessionID string) (err error) {
+	ctx, span := tracing.Start(ctx, "UserService.RemoveSessionDevices")
+	defer func() { tracing.End(span, err) }()
+
+	if sessionID == "" {
+		return nil
+	}
+	return s.DeviceRepo.DeleteBySession(ctx, sessionID)
+}
+
+// issueTokens는 access token 과 refresh token 을 발급합니다.
+func (s *UserService) issueTokens(ctx context.Context, user *model.User, familyID string) (*model.TokenPair, error) {
+	if s.JWTSecret == "" {
+		return nil, apperror.Internal(errors.New("JWT 비밀 키가 설정되지 않았습니다"))
+	}
+
+	now := time.Now()
 	claims := model.BridgerClaims{
-		UserID: user.UserID,
+		UserID:      user.UserID,
+		CompanyID:   user.CompanyID,
+		CompanyRole: user.CompanyRole,
+		Language:    user.Language,
+		SessionID:   familyID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(30 * time.Minute)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ID:        uuid.NewString(),
+			ExpiresAt: jwt.NewNumericDate(now.Add(accessTokenTTL)),
+			IssuedAt:  jwt.NewNumericDate(now),
 			Issuer:    "Bridger",
 		},
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
-	tokenString, err := token.SignedString([]byte(jwtSecret))
+	tokenString, err := token.SignedString([]byte(s.JWTSecret))
+	if err != nil {
+		return nil, apperror.Internal(err)
+	}
+
+	refreshToken, err := newSecretToken()
 	if err != nil {
-		return nil, "", errors.New("토큰 생성 실패")
+		return nil, apperror.Internal(err)
+	}
+	if err := s.TokenRepo.CreateRefreshToken(ctx, &model.RefreshToken{
+		UserID:    user.UserID,
+		FamilyID:  familyID,
+		TokenHash: hashToken(refreshToken),
+		ExpiresAt: now.Add(refreshTokenTTL),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &model.TokenPair{AccessToken: tokenString, RefreshToken: refreshToken}, nil
+}
+
+// newSecretToken은 추측할 수 없는 토큰 원문(refresh token, 이메일 확인 토큰)을 생성합니다.
+func newSecretToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
 	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
 
-	return user, tokenString, nil
+// hashToken은 토큰 원문의 SHA-256 해시를 반환합니다.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
 }
 
 // CheckUserField는 사용자의 Unique 필드가 이미 존재하는지 확인합니다.
@@ -128,13 +765,20 @@ func (s *UserService) Authenticate(email, password string) (*model.User, string,
 //   - email: 사용자의 이메일 주소
 //
 // 반환 값
-//   - error: 데이터가 이미 존재할 경우, 반환되는 오류 메세지
-func (s *UserService) CheckUserField(userID, email string) error {
-	if findUser, _ := s.Repo.FindByID(userID); findUser != nil {
-		return errors.New("userID가 이미 존재합니다")
+//   - error: 이메일이 이미 존재하면 ErrEmailTaken, userID 가 이미 존재하면 ErrDuplicate
+func (s *UserService) CheckUserField(ctx context.Context, userID, email string) (err error) {
+	ctx, span := tracing.Start(ctx, "UserService.CheckUserField")
+	defer func() { tracing.End(span, err) }()
+
+	if _, err := s.Repo.FindByID(ctx, userID); err == nil {
+		return repository.ErrDuplicate.WithDetail("userID")
+	} else if !errors.Is(err, repository.ErrUserNotFound) {
+		return err
 	}
-	if findUser, _ := s.Repo.FindByEmail(email); findUser != nil {
-		return errors.New("email이 이미 존재합니다")
+	if _, err := s.Repo.FindByEmail(ctx, email); err == nil {
+		return repository.ErrEmailTaken
+	} else if !errors.Is(err, repository.ErrUserNotFound) {
+		return err
 	}
 
 	return nil
@@ -143,11 +787,15 @@ func (s *UserService) CheckUserField(userID, email string) error {
 // UpdateProfileImage는 사용자의 프로필 이미지를 업데이트 합니다.
 //
 // 매개 변수
+//   - ctx: 요청 취소 및 deadline 전달용 context
 //   - id: 사용자의 고유 ID
 //   - imageUrl: 이미지 경로
 //
 // 반환 값
 //   - error: 실패 시 error 메세지
-func (s *UserService) UpdateProfileImage(userID string, imageURL string) error {
-	return s.Repo.UpdateProfileImage(userID, imageURL)
+func (s *UserService) UpdateProfileImage(ctx context.Context, userID string, imageURL string) (err error) {
+	ctx, span := tracing.Start(ctx, "UserService.UpdateProfileImage")
+	defer func() { tracing.End(span, err) }()
+
+	return s.Repo.UpdateProfileImage(ctx, userID, imageURL)
 }
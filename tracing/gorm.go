@@ -0,0 +1,99 @@
+package tracing
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+// gorm Statement 에 쿼리 span 을 저장하는 키
+const querySpanKey = "tracing:span"
+
+// gormPlugin은 GORM 쿼리마다 span 을 기록하는 plugin 입니다.
+type gormPlugin struct{}
+
+// GormPlugin은 쿼리마다 종류(create, query, update, delete, row, raw), 테이블, SQL 을 담은 span 을 기록하는 GORM plugin 을 반환합니다.
+// 쿼리의 부모 span 은 db.WithContext(ctx) 로 전달한 context 에서 가져오며, SQL 에는 값 대신 placeholder 가 기록됩니다.
+//
+//	db.Use(tracing.GormPlugin())
+func GormPlugin() gorm.Plugin {
+	return &gormPlugin{}
+}
+
+func (p *gormPlugin) Name() string {
+	return "tracing"
+}
+
+func (p *gormPlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	return errors.Join(
+		cb.Create().Before("gorm:create").Register("tracing:before_create", p.before("create")),
+		cb.Create().After("gorm:create").Register("tracing:after_create", p.after),
+		cb.Query().Before("gorm:query").Register("tracing:before_query", p.before("query")),
+		cb.Query().After("gorm:query").Register("tracing:after_query", p.after),
+		cb.Update().Before("gorm:update").Register("tracing:before_update", p.before("update")),
+		cb.Update().After("gorm:update").Register("tracing:after_update", p.after),
+		cb.Delete().Before("gorm:delete").Register("tracing:before_delete", p.before("delete")),
+		cb.Delete().After("gorm:delete").Register("tracing:after_delete", p.after),
+		cb.Row().Before("gorm:row").Register("tracing:before_row", p.before("row")),
+		cb.Row().After("gorm:row").Register("tracing:after_row", p.after),
+		cb.Raw().Before("gorm:raw").Register("tracing:before_raw", p.before("raw")),
+		cb.Raw().After("gorm:raw").Register("tracing:after_raw", p.after),
+	)
+}
+
+func (p *gormPlugin) before(operation string) func(db *gorm.DB) {
+	return func(db *gorm.DB) {
+		table := db.Statement.Table
+		name := "gorm." + operation
+		if table != "" {
+			name += " " + table
+		}
+
+		_, span := Tracer().Start(db.Statement.Context, name,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				dbSystem(db.Dialector.Name()),
+				semconv.DBOperationName(operation),
+				semconv.DBCollectionName(table),
+			),
+		)
+		db.InstanceSet(querySpanKey, span)
+	}
+}
+
+func (p *gormPlugin) after(db *gorm.DB) {
+	v, ok := db.InstanceGet(querySpanKey)
+	if !ok {
+		return
+	}
+	span, ok := v.(trace.Span)
+	if !ok {
+		return
+	}
+
+	span.SetAttributes(
+		semconv.DBQueryText(db.Statement.SQL.String()),
+		attribute.Int64("db.rows_affected", db.RowsAffected),
+	)
+	err := db.Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = nil
+	}
+	End(span, err)
+}
+
+// dbSystem은 GORM dialector 이름에 해당하는 db.system.name 속성을 반환합니다.
+func dbSystem(dialector string) attribute.KeyValue {
+	switch dialector {
+	case "mysql":
+		return semconv.DBSystemNameMySQL
+	case "sqlite":
+		return semconv.DBSystemNameSQLite
+	default:
+		return semconv.DBSystemNameKey.String(dialector)
+	}
+}
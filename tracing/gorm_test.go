@@ -0,0 +1,70 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/B-Bridger/server/config"
+	"github.com/B-Bridger/server/database"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type tracingTestRecord struct {
+	ID   uint
+	Name string
+}
+
+func TestGormPlugin(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	db, err := database.Connection(config.Database{Driver: config.DriverSQLite, Path: ":memory:"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Use(GormPlugin()); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&tracingTestRecord{}); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, parent := Start(context.Background(), "parent")
+	if err := db.WithContext(ctx).Create(&tracingTestRecord{Name: "a"}).Error; err != nil {
+		t.Fatal(err)
+	}
+	var record tracingTestRecord
+	// 레코드 없음은 실패로 기록하지 않습니다.
+	db.WithContext(ctx).First(&record, 100)
+	// 없는 테이블은 실패로 기록합니다.
+	db.WithContext(ctx).Table("missing").Where("id = ?", 1).Delete(&tracingTestRecord{})
+	parent.End()
+
+	status := map[string]codes.Code{}
+	for _, span := range recorder.Ended() {
+		if span.Parent().SpanID() != parent.SpanContext().SpanID() {
+			continue
+		}
+		status[span.Name()] = span.Status().Code
+	}
+	want := map[string]codes.Code{
+		"gorm.create tracing_test_records": codes.Unset,
+		"gorm.query tracing_test_records":  codes.Unset,
+		"gorm.delete missing":              codes.Error,
+	}
+	for name, code := range want {
+		got, ok := status[name]
+		if !ok {
+			t.Errorf("span %q 이 기록되지 않았습니다: %v", name, status)
+			continue
+		}
+		if got != code {
+			t.Errorf("span %q status = %v, want %v", name, got, code)
+		}
+	}
+}
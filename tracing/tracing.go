@@ -0,0 +1,95 @@
+// tracing 패키지는 OpenTelemetry 기반의 분산 추적을 제공합니다.
+//
+// Setup 으로 exporter 를 설정하면 HTTP 요청(middleware.Tracing), service 메소드, GORM 쿼리(GormPlugin),
+// 번역 요청(translation.Trace)이 하나의 trace 로 기록됩니다.
+// Setup 을 호출하지 않거나 exporter 가 none 이면 span 은 기록되지 않으며 비용도 거의 없습니다.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/B-Bridger/server/buildinfo"
+	"github.com/B-Bridger/server/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// span 을 생성하는 계측 라이브러리 이름
+const instrumentationName = "github.com/B-Bridger/server"
+
+// Tracer는 서버의 span 을 생성하는 tracer 를 반환합니다.
+// Setup 전에 가져온 tracer 도 Setup 이후에는 설정된 exporter 로 기록합니다.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Setup은 설정에 따라 trace exporter 와 전파 방식(W3C traceparent, baggage)을 전역으로 설정합니다.
+//
+// 매개 변수
+//   - ctx: exporter 생성에 사용하는 context
+//   - cfg: trace 설정
+//
+// 반환 값
+//   - func(context.Context) error: 남은 span 을 내보내고 exporter 를 종료하는 함수 (서버 종료 시 호출)
+//   - error: exporter 를 생성할 수 없으면 error 메세지
+func Setup(ctx context.Context, cfg config.Tracing) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var (
+		exporter sdktrace.SpanExporter
+		err      error
+	)
+	switch cfg.Exporter {
+	case config.TracingOTLP:
+		var opts []otlptracehttp.Option
+		if cfg.Endpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpointURL(cfg.Endpoint))
+		}
+		exporter, err = otlptracehttp.New(ctx, opts...)
+	case config.TracingStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	default:
+		return func(context.Context) error { return nil }, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("trace exporter 를 생성할 수 없습니다: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(
+			semconv.ServiceName(cfg.ServiceName),
+			semconv.ServiceVersion(buildinfo.Get().Commit),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// Start는 ctx 의 span 을 부모로 하는 span 을 시작합니다.
+// 반환된 span 은 End 로 종료해야 합니다.
+//
+//	ctx, span := tracing.Start(ctx, "ChatRoomService.CreateChatRoom")
+//	defer func() { tracing.End(span, err) }()
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, opts...)
+}
+
+// End는 err 가 있으면 span 에 기록하고 span 을 종료합니다.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,77 @@
+package translation
+
+import (
+	"fmt"
+	"time"
+)
+
+// ErrorKind는 번역 실패 원인의 분류입니다.
+type ErrorKind string
+
+const (
+	// 사용량 한도(quota) 초과, 재시도해도 성공하지 않습니다.
+	KindQuota ErrorKind = "quota_exceeded"
+	// 요청 속도 제한, 잠시 후 재시도하면 성공할 수 있습니다.
+	KindRateLimit ErrorKind = "rate_limited"
+	// 콘텐츠 필터에 의해 차단된 경우
+	KindContentFilter ErrorKind = "content_filtered"
+	// API 키 등 인증 정보가 잘못된 경우
+	KindAuthentication ErrorKind = "authentication"
+	// 잘못된 요청 (지원하지 않는 모델 등)
+	KindInvalidRequest ErrorKind = "invalid_request"
+	// 제공자 장애, 네트워크 오류, 시간 초과
+	KindUnavailable ErrorKind = "unavailable"
+	// 번역 결과가 용어집을 지키지 않은 경우
+	KindGlossaryViolation ErrorKind = "glossary_violation"
+)
+
+// errors.Is 비교용 error 값
+var (
+	ErrQuotaExceeded     = &Error{Kind: KindQuota}
+	ErrRateLimited       = &Error{Kind: KindRateLimit}
+	ErrContentFiltered   = &Error{Kind: KindContentFilter}
+	ErrAuthentication    = &Error{Kind: KindAuthentication}
+	ErrInvalidRequest    = &Error{Kind: KindInvalidRequest}
+	ErrUnavailable       = &Error{Kind: KindUnavailable}
+	ErrGlossaryViolation = &Error{Kind: KindGlossaryViolation}
+)
+
+// Error는 번역 제공자가 반환하는 오류입니다.
+// errors.Is(err, translation.ErrRateLimited) 처럼 Kind 로 비교할 수 있습니다.
+type Error struct {
+	Kind       ErrorKind
+	Provider   string
+	StatusCode int
+	Message    string
+	// 제공자가 알려준 재시도 대기 시간 (없으면 0)
+	RetryAfter time.Duration
+	Err        error
+}
+
+func (e *Error) Error() string {
+	msg := fmt.Sprintf("translation(%s): %s", e.Provider, e.Kind)
+	if e.StatusCode != 0 {
+		msg += fmt.Sprintf(" (status %d)", e.StatusCode)
+	}
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	return msg
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Kind == e.Kind
+}
+
+// Retryable은 같은 요청을 다시 시도해 볼 만한 오류인지 반환합니다.
+func (e *Error) Retryable() bool {
+	return e.Kind == KindRateLimit || e.Kind == KindUnavailable
+}
@@ -0,0 +1,124 @@
+package translation
+
+import (
+	"context"
+	"regexp"
+	"strings"
+)
+
+// GlossaryEntry는 번역 시 반드시 지켜야 하는 용어 하나입니다.
+// 번역 요청의 대상 언어 기준으로 해석된 값입니다.
+type GlossaryEntry struct {
+	// 원문에 등장하는 용어
+	Source string
+	// 번역문에 사용해야 하는 용어 (DoNotTranslate 이면 무시됩니다)
+	Target string
+	// true 이면 대소문자가 정확히 일치할 때만 용어로 인식합니다.
+	CaseSensitive bool
+	// true 이면 원문 그대로 유지해야 합니다.
+	DoNotTranslate bool
+}
+
+// Expected는 번역문에 포함되어야 하는 용어를 반환합니다.
+func (e GlossaryEntry) Expected() string {
+	if e.DoNotTranslate {
+		return e.Source
+	}
+	return e.Target
+}
+
+// AppearsIn은 text 에 원문 용어가 포함되어 있는지 반환합니다.
+func (e GlossaryEntry) AppearsIn(text string) bool {
+	return containsTerm(text, e.Source, e.CaseSensitive)
+}
+
+// Apply는 text 에 포함된 원문 용어를 번역문 용어로 치환합니다.
+// 실제 번역을 하지 않는 번역기가 용어집을 반영할 때 사용합니다.
+func (e GlossaryEntry) Apply(text string) string {
+	if e.DoNotTranslate || e.Source == "" {
+		return text
+	}
+	pattern := regexp.QuoteMeta(e.Source)
+	if !e.CaseSensitive {
+		pattern = "(?i)" + pattern
+	}
+	return regexp.MustCompile(pattern).ReplaceAllLiteralString(text, e.Target)
+}
+
+// Violations는 원문에 등장한 용어 중 번역문에 지켜지지 않은 용어 목록을 반환합니다.
+//
+// 매개 변수
+//   - req: 번역 요청 정보
+//   - output: 번역 결과
+//
+// 반환 값
+//   - []GlossaryEntry: 지켜지지 않은 용어 목록 (모두 지켜졌으면 nil)
+func Violations(req *Request, output string) []GlossaryEntry {
+	var violations []GlossaryEntry
+	for _, entry := range req.Glossary {
+		if entry.Expected() == "" || !entry.AppearsIn(req.Text) {
+			continue
+		}
+		if !containsTerm(output, entry.Expected(), entry.CaseSensitive) {
+			violations = append(violations, entry)
+		}
+	}
+	return violations
+}
+
+func containsTerm(text, term string, caseSensitive bool) bool {
+	if term == "" {
+		return false
+	}
+	if caseSensitive {
+		return strings.Contains(text, term)
+	}
+	return strings.Contains(strings.ToLower(text), strings.ToLower(term))
+}
+
+// glossaryTranslator는 번역 결과가 용어집을 지키는지 검증하는 Translator 입니다.
+type glossaryTranslator struct {
+	Translator
+}
+
+// EnforceGlossary는 번역 결과가 요청의 용어집을 지키는지 검증하는 Translator 를 반환합니다.
+//
+// 지켜지지 않은 용어가 있으면 해당 용어를 Request.Violations 에 담아 한 번 더 번역을 요청하고,
+// 그래도 지켜지지 않으면 KindGlossaryViolation 오류를 반환합니다.
+func EnforceGlossary(t Translator) Translator {
+	return &glossaryTranslator{Translator: t}
+}
+
+func (t *glossaryTranslator) Ping(ctx context.Context) error {
+	return Ping(ctx, t.Translator)
+}
+
+func (t *glossaryTranslator) Translate(ctx context.Context, req *Request) (*Result, error) {
+	result, err := t.Translator.Translate(ctx, req)
+	if err != nil || len(req.Glossary) == 0 {
+		return result, err
+	}
+
+	violations := Violations(req, result.Text)
+	if len(violations) == 0 {
+		return result, nil
+	}
+
+	retry := *req
+	retry.Violations = violations
+	result, err = t.Translator.Translate(ctx, &retry)
+	if err != nil {
+		return nil, err
+	}
+
+	violations = Violations(req, result.Text)
+	if len(violations) == 0 {
+		return result, nil
+	}
+
+	missing := make([]string, 0, len(violations))
+	for _, v := range violations {
+		missing = append(missing, v.Expected())
+	}
+	return nil, &Error{Kind: KindGlossaryViolation, Provider: t.Name(), Message: "missing glossary terms: " + strings.Join(missing, ", ")}
+}
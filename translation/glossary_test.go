@@ -0,0 +1,111 @@
+package translation_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/B-Bridger/server/translation"
+)
+
+// scripted는 outputs 를 순서대로 번역 결과로 반환하고 받은 요청을 기록하는 Translator 입니다.
+type scripted struct {
+	outputs  []string
+	requests []translation.Request
+}
+
+func (t *scripted) Name() string { return "scripted" }
+
+func (t *scripted) Translate(ctx context.Context, req *translation.Request) (*translation.Result, error) {
+	output := t.outputs[min(len(t.requests), len(t.outputs)-1)]
+	t.requests = append(t.requests, *req)
+	return &translation.Result{Text: output, Provider: t.Name()}, nil
+}
+
+var glossary = []translation.GlossaryEntry{
+	{Source: "브리저", Target: "Bridger"},
+	{Source: "BB", DoNotTranslate: true, CaseSensitive: true},
+	{Source: "서버", Target: "server"},
+}
+
+func TestViolations(t *testing.T) {
+	req := &translation.Request{Text: "브리저 BB 앱", Glossary: glossary}
+
+	cases := []struct {
+		output string
+		want   []string
+	}{
+		{"The BRIDGER BB app", nil},
+		{"The Bridger bb app", []string{"BB"}},
+		{"The bridge app", []string{"Bridger", "BB"}},
+	}
+	for _, tc := range cases {
+		var got []string
+		for _, v := range translation.Violations(req, tc.output) {
+			got = append(got, v.Expected())
+		}
+		if len(got) != len(tc.want) {
+			t.Fatalf("Violations(%q) = %v, want %v", tc.output, got, tc.want)
+		}
+		for i := range got {
+			if got[i] != tc.want[i] {
+				t.Fatalf("Violations(%q) = %v, want %v", tc.output, got, tc.want)
+			}
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	if got := (translation.GlossaryEntry{Source: "bridger", Target: "브리저"}).Apply("Bridger and BRIDGER"); got != "브리저 and 브리저" {
+		t.Fatalf("case insensitive = %q", got)
+	}
+	if got := (translation.GlossaryEntry{Source: "BB", Target: "비비", CaseSensitive: true}).Apply("BB bb"); got != "비비 bb" {
+		t.Fatalf("case sensitive = %q", got)
+	}
+	if got := (translation.GlossaryEntry{Source: "BB", DoNotTranslate: true}).Apply("BB"); got != "BB" {
+		t.Fatalf("do not translate = %q", got)
+	}
+}
+
+func TestEnforceGlossary(t *testing.T) {
+	req := &translation.Request{Text: "브리저 서버", SourceLanguage: "ko", TargetLanguage: "en", Glossary: glossary}
+
+	t.Run("kept", func(t *testing.T) {
+		inner := &scripted{outputs: []string{"Bridger server"}}
+		result, err := translation.EnforceGlossary(inner).Translate(context.Background(), req)
+		if err != nil || result.Text != "Bridger server" || len(inner.requests) != 1 {
+			t.Fatalf("result = %+v, err = %v, requests = %d", result, err, len(inner.requests))
+		}
+	})
+
+	t.Run("retried", func(t *testing.T) {
+		inner := &scripted{outputs: []string{"Bridge server", "Bridger server"}}
+		result, err := translation.EnforceGlossary(inner).Translate(context.Background(), req)
+		if err != nil || result.Text != "Bridger server" {
+			t.Fatalf("result = %+v, err = %v", result, err)
+		}
+		if len(inner.requests) != 2 {
+			t.Fatalf("requests = %d, want 2", len(inner.requests))
+		}
+		// 재요청에는 지켜지지 않은 용어만 담기고, 원래 요청은 바뀌지 않습니다.
+		if v := inner.requests[1].Violations; len(v) != 1 || v[0].Target != "Bridger" || req.Violations != nil {
+			t.Fatalf("violations = %+v, original = %+v", v, req.Violations)
+		}
+	})
+
+	t.Run("violated", func(t *testing.T) {
+		inner := &scripted{outputs: []string{"Bridge server"}}
+		_, err := translation.EnforceGlossary(inner).Translate(context.Background(), req)
+		if !errors.Is(err, translation.ErrGlossaryViolation) || len(inner.requests) != 2 {
+			t.Fatalf("err = %v, requests = %d", err, len(inner.requests))
+		}
+	})
+
+	t.Run("no glossary", func(t *testing.T) {
+		inner := &scripted{outputs: []string{"anything"}}
+		plain := &translation.Request{Text: "브리저", SourceLanguage: "ko", TargetLanguage: "en"}
+		if _, err := translation.EnforceGlossary(inner).Translate(context.Background(), plain); err != nil || len(inner.requests) != 1 {
+			t.Fatalf("err = %v, requests = %d", err, len(inner.requests))
+		}
+	})
+}
@@ -0,0 +1,42 @@
+package translation
+
+import (
+	"context"
+	"time"
+)
+
+// Observer는 번역 요청 결과를 기록하는 역할을 추상화합니다. (metrics 등)
+type Observer interface {
+	// 번역 요청 하나의 결과를 기록합니다.
+	//
+	// 매개 변수
+	//   - provider: 번역 제공자 이름
+	//   - req: 번역 요청 정보
+	//   - elapsed: 요청에 걸린 시간
+	//   - result: 번역 결과 (실패 시 nil)
+	//   - err: 실패 시 error
+	ObserveTranslation(provider string, req *Request, elapsed time.Duration, result *Result, err error)
+}
+
+// observedTranslator는 번역 요청마다 Observer 에 결과를 전달하는 Translator 입니다.
+type observedTranslator struct {
+	Translator
+	observer Observer
+}
+
+// Instrument는 번역 요청마다 소요 시간과 결과를 observer 에 전달하는 Translator 를 반환합니다.
+// EnforceGlossary 안쪽에 두면 용어집 재요청도 각각 기록됩니다.
+func Instrument(t Translator, observer Observer) Translator {
+	return &observedTranslator{Translator: t, observer: observer}
+}
+
+func (t *observedTranslator) Ping(ctx context.Context) error {
+	return Ping(ctx, t.Translator)
+}
+
+func (t *observedTranslator) Translate(ctx context.Context, req *Request) (*Result, error) {
+	start := time.Now()
+	result, err := t.Translator.Translate(ctx, req)
+	t.observer.ObserveTranslation(t.Name(), req, time.Since(start), result, err)
+	return result, err
+}
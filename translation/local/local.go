@@ -0,0 +1,45 @@
+// local 패키지는 외부 API 없이 동작하는 결정적(deterministic) 번역기를 제공합니다.
+// 테스트와 오프라인 개발 환경에서 사용합니다.
+package local
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/B-Bridger/server/translation"
+)
+
+const providerName = "local"
+
+// Translator는 원문 앞에 언어 쌍을 붙여 반환합니다.
+// 예: "안녕하세요" (ko → en) => "[ko→en] 안녕하세요"
+// 원문 언어와 대상 언어가 같으면 원문을 그대로 반환합니다.
+// 용어집이 주어지면 원문 용어를 번역문 용어로 치환합니다.
+type Translator struct{}
+
+func New() *Translator {
+	return &Translator{}
+}
+
+func (t *Translator) Name() string {
+	return providerName
+}
+
+func (t *Translator) Translate(ctx context.Context, req *translation.Request) (*translation.Result, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, &translation.Error{Kind: translation.KindUnavailable, Provider: providerName, Err: err}
+	}
+	if req.TargetLanguage == "" {
+		return nil, &translation.Error{Kind: translation.KindInvalidRequest, Provider: providerName, Message: "target language is empty"}
+	}
+
+	text := req.Text
+	if req.SourceLanguage != req.TargetLanguage {
+		for _, entry := range req.Glossary {
+			text = entry.Apply(text)
+		}
+		text = fmt.Sprintf("[%s→%s] %s", req.SourceLanguage, req.TargetLanguage, text)
+	}
+
+	return &translation.Result{Text: text, Provider: providerName}, nil
+}
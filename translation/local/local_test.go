@@ -0,0 +1,53 @@
+package local_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/B-Bridger/server/translation"
+	"github.com/B-Bridger/server/translation/local"
+)
+
+func TestTranslate(t *testing.T) {
+	tr := local.New()
+	glossary := []translation.GlossaryEntry{
+		{Source: "브리저", Target: "Bridger"},
+		{Source: "BB", DoNotTranslate: true},
+	}
+
+	cases := []struct {
+		name string
+		req  translation.Request
+		want string
+	}{
+		{"language pair", translation.Request{Text: "안녕하세요", SourceLanguage: "ko", TargetLanguage: "en"}, "[ko→en] 안녕하세요"},
+		{"same language", translation.Request{Text: "브리저", SourceLanguage: "ko", TargetLanguage: "ko", Glossary: glossary}, "브리저"},
+		{"glossary", translation.Request{Text: "브리저 BB", SourceLanguage: "ko", TargetLanguage: "en", Glossary: glossary}, "[ko→en] Bridger BB"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := tr.Translate(context.Background(), &tc.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Text != tc.want || result.Provider != tr.Name() {
+				t.Fatalf("result = %+v, want %q", result, tc.want)
+			}
+		})
+	}
+}
+
+func TestTranslateErrors(t *testing.T) {
+	tr := local.New()
+
+	if _, err := tr.Translate(context.Background(), &translation.Request{Text: "안녕", SourceLanguage: "ko"}); !errors.Is(err, translation.ErrInvalidRequest) {
+		t.Fatalf("empty target: err = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := tr.Translate(ctx, &translation.Request{Text: "안녕", SourceLanguage: "ko", TargetLanguage: "en"}); !errors.Is(err, translation.ErrUnavailable) || !errors.Is(err, context.Canceled) {
+		t.Fatalf("canceled: err = %v", err)
+	}
+}
@@ -0,0 +1,331 @@
+// openai 패키지는 OpenAI Chat Completions API 를 사용하는 번역기를 제공합니다.
+package openai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/B-Bridger/server/translation"
+)
+
+const (
+	providerName = "openai"
+
+	DefaultBaseURL      = "https://api.openai.com/v1"
+	DefaultModel        = "gpt-4o-mini"
+	DefaultTimeout      = 30 * time.Second
+	DefaultRetryBackoff = 500 * time.Millisecond
+	// 재시도 대기 시간의 상한
+	maxRetryBackoff = 10 * time.Second
+)
+
+// Config는 OpenAI 번역기 설정입니다.
+type Config struct {
+	APIKey  string
+	Model   string
+	BaseURL string
+	// 요청 1회(재시도 포함하지 않음)에 허용되는 시간
+	Timeout time.Duration
+	// 재시도 가능한 오류에 대한 최대 재시도 횟수
+	MaxRetries int
+	// 첫 재시도 대기 시간, 이후 재시도마다 두 배로 증가합니다.
+	RetryBackoff time.Duration
+	// 지정하지 않으면 http.DefaultClient 를 사용합니다.
+	HTTPClient *http.Client
+}
+
+// Translator는 OpenAI Chat Completions API 를 호출하는 번역기입니다.
+type Translator struct {
+	cfg Config
+}
+
+// New는 주어진 설정으로 번역기를 생성합니다. 비어있는 값은 기본값으로 채웁니다.
+func New(cfg Config) *Translator {
+	if cfg.Model == "" {
+		cfg.Model = DefaultModel
+	}
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = DefaultBaseURL
+	}
+	cfg.BaseURL = strings.TrimRight(cfg.BaseURL, "/")
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	if cfg.MaxRetries < 0 {
+		cfg.MaxRetries = 0
+	}
+	if cfg.RetryBackoff <= 0 {
+		cfg.RetryBackoff = DefaultRetryBackoff
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = http.DefaultClient
+	}
+	return &Translator{cfg: cfg}
+}
+
+func (t *Translator) Name() string {
+	return providerName
+}
+
+// Translate는 원문을 대상 언어로 번역합니다.
+// 속도 제한(429)과 서버 오류(5xx), 네트워크 오류는 지수 백오프로 재시도하며,
+// 응답에 Retry-After 헤더가 있으면 그 값을 우선합니다.
+func (t *Translator) Translate(ctx context.Context, req *translation.Request) (*translation.Result, error) {
+	body, err := json.Marshal(t.buildRequest(req))
+	if err != nil {
+		return nil, &translation.Error{Kind: translation.KindInvalidRequest, Provider: providerName, Err: err}
+	}
+
+	backoff := t.cfg.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		result, err := t.do(ctx, body)
+		if err == nil {
+			return result, nil
+		}
+
+		var terr *translation.Error
+		if !errors.As(err, &terr) || !terr.Retryable() || attempt >= t.cfg.MaxRetries || ctx.Err() != nil {
+			return nil, err
+		}
+
+		wait := backoff
+		if terr.RetryAfter > 0 {
+			wait = terr.RetryAfter
+		}
+		if wait > maxRetryBackoff {
+			wait = maxRetryBackoff
+		}
+		backoff *= 2
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, &translation.Error{Kind: translation.KindUnavailable, Provider: providerName, Err: ctx.Err()}
+		case <-timer.C:
+		}
+	}
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatRequest struct {
+	Model       string        `json:"model"`
+	Messages    []chatMessage `json:"messages"`
+	Temperature float64       `json:"temperature"`
+}
+
+type chatResponse struct {
+	Choices []struct {
+		Message      chatMessage `json:"message"`
+		FinishReason string      `json:"finish_reason"`
+	} `json:"choices"`
+	Usage struct {
+		PromptTokens     int `json:"prompt_tokens"`
+		CompletionTokens int `json:"completion_tokens"`
+	} `json:"usage"`
+}
+
+type errorResponse struct {
+	Error struct {
+		Message string `json:"message"`
+		Type    string `json:"type"`
+		Code    string `json:"code"`
+	} `json:"error"`
+}
+
+func (t *Translator) buildRequest(req *translation.Request) *chatRequest {
+	var prompt strings.Builder
+	prompt.WriteString("You are a professional interpreter for business-to-business conversations. ")
+	fmt.Fprintf(&prompt, "Translate the user's message from %s to %s. ", languageOrAuto(req.SourceLanguage), req.TargetLanguage)
+	prompt.WriteString("Preserve names, numbers, formatting and tone. Reply with the translation only, without explanations or quotes.")
+
+	writeGlossary(&prompt, req)
+
+	if len(req.Context) > 0 {
+		prompt.WriteString("\n\nRecent conversation for context (do not translate):\n")
+		for _, line := range req.Context {
+			prompt.WriteString("- ")
+			prompt.WriteString(line)
+			prompt.WriteString("\n")
+		}
+	}
+
+	return &chatRequest{
+		Model: t.cfg.Model,
+		Messages: []chatMessage{
+			{Role: "system", Content: prompt.String()},
+			{Role: "user", Content: req.Text},
+		},
+		Temperature: 0,
+	}
+}
+
+// writeGlossary는 용어집과 이전 번역에서 지켜지지 않은 용어를 프롬프트에 추가합니다.
+func writeGlossary(prompt *strings.Builder, req *translation.Request) {
+	var terms, keep []translation.GlossaryEntry
+	for _, entry := range req.Glossary {
+		switch {
+		case entry.DoNotTranslate:
+			keep = append(keep, entry)
+		case entry.Target != "":
+			terms = append(terms, entry)
+		}
+	}
+
+	if len(terms) > 0 {
+		prompt.WriteString("\n\nGlossary (always translate these terms exactly as given):\n")
+		for _, entry := range terms {
+			fmt.Fprintf(prompt, "- %q => %q\n", entry.Source, entry.Target)
+		}
+	}
+	if len(keep) > 0 {
+		prompt.WriteString("\n\nKeep these terms exactly as written, do not translate or transliterate them:\n")
+		for _, entry := range keep {
+			fmt.Fprintf(prompt, "- %q\n", entry.Source)
+		}
+	}
+	if len(req.Violations) > 0 {
+		prompt.WriteString("\n\nYour previous translation did not contain the following required terms. The translation MUST contain each of them verbatim:\n")
+		for _, entry := range req.Violations {
+			fmt.Fprintf(prompt, "- %q\n", entry.Expected())
+		}
+	}
+}
+
+func languageOrAuto(language string) string {
+	if language == "" {
+		return "the detected language"
+	}
+	return language
+}
+
+// Ping은 모델 목록 API 를 호출하여 OpenAI 에 연결할 수 있고 API 키가 올바른지 확인합니다.
+// 토큰을 사용하지 않으며 재시도하지 않습니다.
+func (t *Translator) Ping(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, t.cfg.Timeout)
+	defer cancel()
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, t.cfg.BaseURL+"/models/"+t.cfg.Model, nil)
+	if err != nil {
+		return &translation.Error{Kind: translation.KindInvalidRequest, Provider: providerName, Err: err}
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+t.cfg.APIKey)
+
+	resp, err := t.cfg.HTTPClient.Do(httpReq)
+	if err != nil {
+		return &translation.Error{Kind: translation.KindUnavailable, Provider: providerName, Err: err}
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return &translation.Error{Kind: translation.KindUnavailable, Provider: providerName, StatusCode: resp.StatusCode, Err: err}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return parseError(resp, body)
+	}
+	return nil
+}
+
+// do는 재시도 없이 API 를 한 번 호출합니다.
+func (t *Translator) do(ctx context.Context, body []byte) (*translation.Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, t.cfg.Timeout)
+	defer cancel()
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, t.cfg.BaseURL+"/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return nil, &translation.Error{Kind: translation.KindInvalidRequest, Provider: providerName, Err: err}
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+t.cfg.APIKey)
+
+	resp, err := t.cfg.HTTPClient.Do(httpReq)
+	if err != nil {
+		return nil, &translation.Error{Kind: translation.KindUnavailable, Provider: providerName, Err: err}
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &translation.Error{Kind: translation.KindUnavailable, Provider: providerName, StatusCode: resp.StatusCode, Err: err}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, parseError(resp, respBody)
+	}
+
+	var parsed chatResponse
+	if err := json.Unmarshal(respBody, &parsed); err != nil {
+		return nil, &translation.Error{Kind: translation.KindUnavailable, Provider: providerName, StatusCode: resp.StatusCode, Message: "invalid response body", Err: err}
+	}
+	if len(parsed.Choices) == 0 {
+		return nil, &translation.Error{Kind: translation.KindUnavailable, Provider: providerName, StatusCode: resp.StatusCode, Message: "response has no choices"}
+	}
+
+	choice := parsed.Choices[0]
+	if choice.FinishReason == "content_filter" {
+		return nil, &translation.Error{Kind: translation.KindContentFilter, Provider: providerName, StatusCode: resp.StatusCode, Message: "output was blocked by content filter"}
+	}
+
+	return &translation.Result{
+		Text:             strings.TrimSpace(choice.Message.Content),
+		Provider:         providerName,
+		PromptTokens:     parsed.Usage.PromptTokens,
+		CompletionTokens: parsed.Usage.CompletionTokens,
+	}, nil
+}
+
+// parseError는 OpenAI 오류 응답을 translation.Error 로 변환합니다.
+func parseError(resp *http.Response, body []byte) error {
+	var parsed errorResponse
+	_ = json.Unmarshal(body, &parsed)
+
+	terr := &translation.Error{
+		Provider:   providerName,
+		StatusCode: resp.StatusCode,
+		Message:    parsed.Error.Message,
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests && parsed.Error.Code == "insufficient_quota":
+		terr.Kind = translation.KindQuota
+	case resp.StatusCode == http.StatusTooManyRequests:
+		terr.Kind = translation.KindRateLimit
+	case parsed.Error.Code == "content_filter" || parsed.Error.Code == "content_policy_violation":
+		terr.Kind = translation.KindContentFilter
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		terr.Kind = translation.KindAuthentication
+	case resp.StatusCode >= http.StatusInternalServerError:
+		terr.Kind = translation.KindUnavailable
+	default:
+		terr.Kind = translation.KindInvalidRequest
+	}
+
+	return terr
+}
+
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return time.Until(at)
+	}
+	return 0
+}
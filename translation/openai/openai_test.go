@@ -0,0 +1,222 @@
+package openai_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/B-Bridger/server/translation"
+	"github.com/B-Bridger/server/translation/openai"
+)
+
+// reply는 대체 서버의 응답 하나입니다.
+type reply struct {
+	status int
+	header map[string]string
+	body   string
+	// 응답 전에 기다리는 시간, 요청이 취소되면 바로 끝냅니다.
+	delay time.Duration
+}
+
+func success(text string) reply {
+	return reply{status: http.StatusOK, body: fmt.Sprintf(`{"choices":[{"message":{"role":"assistant","content":%q},"finish_reason":"stop"}],"usage":{"prompt_tokens":12,"completion_tokens":3}}`, text)}
+}
+
+func failure(status int, code string) reply {
+	return reply{status: status, body: fmt.Sprintf(`{"error":{"message":"%s","type":"error","code":%q}}`, code, code)}
+}
+
+// fakeOpenAI는 Chat Completions API 를 흉내 내는 로컬 대체 서버입니다.
+// replies 를 순서대로 응답하며, 모두 사용하면 마지막 응답을 반복합니다.
+type fakeOpenAI struct {
+	mu       sync.Mutex
+	replies  []reply
+	requests []time.Time
+	bodies   []map[string]any
+}
+
+func newServer(t *testing.T, replies ...reply) (*fakeOpenAI, *httptest.Server) {
+	t.Helper()
+
+	f := &fakeOpenAI{replies: replies}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/chat/completions" || r.Header.Get("Authorization") != "Bearer test-key" {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+
+		f.mu.Lock()
+		i := min(len(f.requests), len(f.replies)-1)
+		f.requests = append(f.requests, time.Now())
+		f.bodies = append(f.bodies, body)
+		resp := f.replies[i]
+		f.mu.Unlock()
+
+		select {
+		case <-time.After(resp.delay):
+		case <-r.Context().Done():
+			return
+		}
+		for k, v := range resp.header {
+			w.Header().Set(k, v)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(resp.status)
+		fmt.Fprint(w, resp.body)
+	}))
+	t.Cleanup(srv.Close)
+	return f, srv
+}
+
+func (f *fakeOpenAI) count() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.requests)
+}
+
+func newTranslator(srv *httptest.Server, cfg openai.Config) *openai.Translator {
+	cfg.APIKey = "test-key"
+	cfg.BaseURL = srv.URL
+	return openai.New(cfg)
+}
+
+var request = &translation.Request{Text: "안녕하세요", SourceLanguage: "ko", TargetLanguage: "en"}
+
+func TestTranslate(t *testing.T) {
+	f, srv := newServer(t, success("  Hello  "))
+	tr := newTranslator(srv, openai.Config{Model: "test-model"})
+
+	req := *request
+	req.Glossary = []translation.GlossaryEntry{{Source: "브리저", Target: "Bridger"}}
+	result, err := tr.Translate(context.Background(), &req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Text != "Hello" || result.Provider != "openai" || result.PromptTokens != 12 || result.CompletionTokens != 3 {
+		t.Fatalf("result = %+v", result)
+	}
+
+	body := f.bodies[0]
+	messages := body["messages"].([]any)
+	system := messages[0].(map[string]any)["content"].(string)
+	if body["model"] != "test-model" || !strings.Contains(system, `"브리저" => "Bridger"`) || messages[1].(map[string]any)["content"] != "안녕하세요" {
+		t.Fatalf("request = %+v", body)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	t.Run("backoff", func(t *testing.T) {
+		f, srv := newServer(t, failure(http.StatusInternalServerError, "server_error"), failure(http.StatusServiceUnavailable, "server_error"), success("Hello"))
+		tr := newTranslator(srv, openai.Config{MaxRetries: 3, RetryBackoff: 20 * time.Millisecond})
+
+		if _, err := tr.Translate(context.Background(), request); err != nil {
+			t.Fatal(err)
+		}
+		if f.count() != 3 {
+			t.Fatalf("requests = %d, want 3", f.count())
+		}
+		// 대기 시간은 재시도마다 두 배로 늘어납니다. (20ms, 40ms)
+		if first, second := f.requests[1].Sub(f.requests[0]), f.requests[2].Sub(f.requests[1]); first < 20*time.Millisecond || second < 40*time.Millisecond {
+			t.Fatalf("backoff = %v, %v", first, second)
+		}
+	})
+
+	t.Run("retry after", func(t *testing.T) {
+		rateLimited := failure(http.StatusTooManyRequests, "rate_limit_exceeded")
+		rateLimited.header = map[string]string{"Retry-After": "1"}
+		f, srv := newServer(t, rateLimited, success("Hello"))
+		tr := newTranslator(srv, openai.Config{MaxRetries: 1, RetryBackoff: time.Millisecond})
+
+		if _, err := tr.Translate(context.Background(), request); err != nil {
+			t.Fatal(err)
+		}
+		if wait := f.requests[1].Sub(f.requests[0]); wait < time.Second {
+			t.Fatalf("wait = %v, Retry-After(1s) 를 따르지 않았습니다", wait)
+		}
+	})
+
+	t.Run("gives up", func(t *testing.T) {
+		f, srv := newServer(t, failure(http.StatusServiceUnavailable, "server_error"))
+		tr := newTranslator(srv, openai.Config{MaxRetries: 2, RetryBackoff: time.Millisecond})
+
+		if _, err := tr.Translate(context.Background(), request); !errors.Is(err, translation.ErrUnavailable) {
+			t.Fatalf("err = %v, want ErrUnavailable", err)
+		}
+		if f.count() != 3 {
+			t.Fatalf("requests = %d, want 3", f.count())
+		}
+	})
+
+	t.Run("attempt timeout", func(t *testing.T) {
+		// 첫 요청은 Timeout 보다 오래 걸려 중단되고, 재시도한 요청이 성공합니다.
+		slow := success("too late")
+		slow.delay = time.Second
+		f, srv := newServer(t, slow, success("Hello"))
+		tr := newTranslator(srv, openai.Config{Timeout: 50 * time.Millisecond, MaxRetries: 1, RetryBackoff: time.Millisecond})
+
+		start := time.Now()
+		result, err := tr.Translate(context.Background(), request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Text != "Hello" || f.count() != 2 || time.Since(start) > 500*time.Millisecond {
+			t.Fatalf("result = %+v, requests = %d, elapsed = %v", result, f.count(), time.Since(start))
+		}
+	})
+
+	t.Run("canceled while waiting", func(t *testing.T) {
+		_, srv := newServer(t, failure(http.StatusServiceUnavailable, "server_error"))
+		tr := newTranslator(srv, openai.Config{MaxRetries: 3, RetryBackoff: 5 * time.Second})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		if _, err := tr.Translate(ctx, request); !errors.Is(err, translation.ErrUnavailable) || !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("err = %v", err)
+		}
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Fatalf("elapsed = %v, 취소된 뒤에도 재시도를 기다렸습니다", elapsed)
+		}
+	})
+}
+
+func TestErrorClassification(t *testing.T) {
+	cases := []struct {
+		name  string
+		reply reply
+		want  error
+		// 재시도 가능한 오류는 MaxRetries(1) 만큼 한 번 더 요청합니다.
+		requests int
+	}{
+		{"quota", failure(http.StatusTooManyRequests, "insufficient_quota"), translation.ErrQuotaExceeded, 1},
+		{"rate limited", failure(http.StatusTooManyRequests, "rate_limit_exceeded"), translation.ErrRateLimited, 2},
+		{"content policy", failure(http.StatusBadRequest, "content_policy_violation"), translation.ErrContentFiltered, 1},
+		{"content filter finish", reply{status: http.StatusOK, body: `{"choices":[{"message":{"role":"assistant","content":""},"finish_reason":"content_filter"}]}`}, translation.ErrContentFiltered, 1},
+		{"authentication", failure(http.StatusUnauthorized, "invalid_api_key"), translation.ErrAuthentication, 1},
+		{"invalid request", failure(http.StatusNotFound, "model_not_found"), translation.ErrInvalidRequest, 1},
+		{"server error", failure(http.StatusBadGateway, "server_error"), translation.ErrUnavailable, 2},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			f, srv := newServer(t, tc.reply)
+			tr := newTranslator(srv, openai.Config{MaxRetries: 1, RetryBackoff: time.Millisecond})
+
+			_, err := tr.Translate(context.Background(), request)
+			if !errors.Is(err, tc.want) {
+				t.Fatalf("err = %v, want %v", err, tc.want)
+			}
+			if f.count() != tc.requests {
+				t.Fatalf("requests = %d, want %d", f.count(), tc.requests)
+			}
+		})
+	}
+}
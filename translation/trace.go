@@ -0,0 +1,54 @@
+package translation
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracedTranslator는 번역 요청마다 span 을 기록하는 Translator 입니다.
+type tracedTranslator struct {
+	Translator
+	tracer trace.Tracer
+}
+
+// Trace는 번역 요청마다 제공자, 언어 쌍, 토큰 사용량을 담은 span 을 기록하는 Translator 를 반환합니다.
+// EnforceGlossary 안쪽에 두면 용어집 재요청도 각각 기록됩니다.
+func Trace(t Translator) Translator {
+	return &tracedTranslator{Translator: t, tracer: otel.Tracer("github.com/B-Bridger/server/translation")}
+}
+
+func (t *tracedTranslator) Ping(ctx context.Context) error {
+	return Ping(ctx, t.Translator)
+}
+
+func (t *tracedTranslator) Translate(ctx context.Context, req *Request) (*Result, error) {
+	ctx, span := t.tracer.Start(ctx, "translation.Translate "+t.Name(),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("translation.provider", t.Name()),
+			attribute.String("translation.source_language", req.SourceLanguage),
+			attribute.String("translation.target_language", req.TargetLanguage),
+			attribute.Int("translation.text_length", len(req.Text)),
+			attribute.Int("translation.glossary_terms", len(req.Glossary)),
+			attribute.Int("translation.violations", len(req.Violations)),
+		),
+	)
+	defer span.End()
+
+	result, err := t.Translator.Translate(ctx, req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return result, err
+	}
+	span.SetAttributes(
+		semconv.GenAIUsageInputTokens(result.PromptTokens),
+		semconv.GenAIUsageOutputTokens(result.CompletionTokens),
+	)
+	return result, nil
+}
@@ -0,0 +1,62 @@
+package translation
+
+import "context"
+
+// Request는 번역 요청 정보입니다.
+type Request struct {
+	// 번역할 원문
+	Text string
+	// 원문 언어 (예: ko, en, ja)
+	SourceLanguage string
+	// 번역할 언어
+	TargetLanguage string
+	// 번역 품질을 위한 대화 맥락 (이전 메세지 등, 오래된 순)
+	Context []string
+	// 대상 언어 기준으로 반드시 지켜야 하는 용어 목록
+	Glossary []GlossaryEntry
+	// 이전 번역에서 지켜지지 않은 용어 목록 (재요청 시에만 채워집니다)
+	Violations []GlossaryEntry
+}
+
+// Result는 번역 결과입니다.
+type Result struct {
+	Text     string
+	Provider string
+	// 제공자가 보고한 토큰 사용량 (지원하지 않으면 0)
+	PromptTokens     int
+	CompletionTokens int
+}
+
+// 번역 엔진을 추상화한 인터페이스입니다.
+// 구현은 openai, local 패키지에서 진행합니다.
+type Translator interface {
+	// 번역 제공자의 이름을 반환합니다.
+	Name() string
+
+	// 원문을 대상 언어로 번역합니다.
+	//
+	// 매개 변수
+	//   - ctx: 요청 취소 및 deadline 전달용 context
+	//   - req: 번역 요청 정보
+	//
+	// 반환 값
+	//   - *Result: 번역 결과
+	//   - error: 실패 시 *Error 타입의 error
+	Translate(ctx context.Context, req *Request) (*Result, error)
+}
+
+// Pinger는 번역 제공자에 연결할 수 있는지 확인할 수 있는 Translator 입니다.
+// 외부 API 를 사용하지 않는 번역기는 구현하지 않아도 됩니다.
+type Pinger interface {
+	// 번역 제공자에 연결할 수 있고 인증 정보가 올바른지 확인합니다.
+	Ping(ctx context.Context) error
+}
+
+// Ping은 t 가 Pinger 이면 번역 제공자에 연결할 수 있는지 확인합니다.
+// Pinger 가 아니면 항상 연결할 수 있는 것으로 보고 nil 을 반환합니다.
+func Ping(ctx context.Context, t Translator) error {
+	if p, ok := t.(Pinger); ok {
+		return p.Ping(ctx)
+	}
+	return nil
+}